@@ -14,16 +14,18 @@ const (
 	Testnet Network = 1
 )
 
+// IsMainnet reports whether an optional network argument selects mainnet.
+// Mainnet is assumed when no network is given.
+func IsMainnet(network ...Network) bool {
+	return len(network) == 0 || network[0] != Testnet
+}
+
 // PKHash is a wrapper around a byte slice representing a public key hash
 type PKHash []byte
 
 // Address returns the address string representation of the public key hash
 func (p *PKHash) Address(network ...Network) string {
-	mainnet := true
-	if len(network) > 0 {
-		mainnet = network[0] != Testnet
-	}
-	add, _ := script.NewAddressFromPublicKeyHash(*p, mainnet)
+	add, _ := script.NewAddressFromPublicKeyHash(*p, IsMainnet(network...))
 	return add.AddressString
 }
 
@@ -46,3 +46,15 @@ func TestPKHashFromAddress(t *testing.T) {
 		t.Error("expected error for invalid address")
 	}
 }
+
+func TestIsMainnet(t *testing.T) {
+	if !IsMainnet() {
+		t.Error("expected mainnet by default")
+	}
+	if !IsMainnet(Mainnet) {
+		t.Error("expected mainnet")
+	}
+	if IsMainnet(Testnet) {
+		t.Error("expected testnet")
+	}
+}
@@ -1,6 +1,14 @@
 package bitcom
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/bsv-blockchain/go-sdk/script"
 )
 
@@ -23,10 +31,25 @@ const (
 type Encoding string
 
 var (
-	EncodingUTF8  Encoding = "utf-8"
-	EncodingBinay Encoding = "binary"
+	EncodingUTF8   Encoding = "utf-8"
+	EncodingBinary Encoding = "binary"
+	EncodingGzip   Encoding = "gzip"
+	EncodingBase64 Encoding = "base64"
+
+	// Deprecated: use EncodingBinary
+	EncodingBinay = EncodingBinary
 )
 
+var (
+	ErrUnsupportedEncoding = errors.New("unsupported B encoding")
+	ErrEncodingMismatch    = errors.New("B data does not match its encoding")
+)
+
+// MaxDecodedSize is the most data DecodeB will decompress from a gzip push.
+// It matches the default node policy limit on transaction size, so a small
+// gzip bomb in an untrusted output cannot force a larger allocation.
+const MaxDecodedSize = 10 << 20
+
 // B represents B protocol data
 type B struct {
 	MediaType MediaType `json:"mediaType"`
@@ -35,10 +58,82 @@ type B struct {
 	Filename  string    `json:"filename,omitempty"`
 }
 
+// Validate checks that Data can be written with Encoding: utf-8 data must be
+// valid UTF-8, and the encoding must be one of the supported encodings or
+// absent
+func (b *B) Validate() error {
+	switch b.normalizedEncoding() {
+	case "", EncodingBinary, EncodingGzip, EncodingBase64:
+	case EncodingUTF8:
+		if !utf8.Valid(b.Data) {
+			return ErrEncodingMismatch
+		}
+	default:
+		return ErrUnsupportedEncoding
+	}
+	return nil
+}
+
+// normalizedEncoding returns Encoding in lower case, with "utf8" read as utf-8
+func (b *B) normalizedEncoding() Encoding {
+	encoding := Encoding(strings.ToLower(string(b.Encoding)))
+	if encoding == "utf8" {
+		return EncodingUTF8
+	}
+	return encoding
+}
+
+// Protocol encodes the B data as a Bitcom protocol. Data is compressed or
+// base64 encoded when Encoding is gzip or base64, and an empty media type or
+// encoding is written as an empty push.
+func (b *B) Protocol() (*BitcomProtocol, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	data := b.Data
+	switch b.normalizedEncoding() {
+	case EncodingGzip:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(b.Data); err != nil {
+			return nil, err
+		} else if err = zw.Close(); err != nil {
+			return nil, err
+		}
+		data = buf.Bytes()
+	case EncodingBase64:
+		data = []byte(base64.StdEncoding.EncodeToString(b.Data))
+	}
+
+	s := &script.Script{}
+	_ = s.AppendPushData(data)
+	_ = s.AppendPushDataString(string(b.MediaType))
+	_ = s.AppendPushDataString(string(b.Encoding))
+	if b.Filename != "" {
+		_ = s.AppendPushDataString(b.Filename)
+	}
+	return &BitcomProtocol{
+		Protocol: BPrefix,
+		Script:   *s,
+	}, nil
+}
+
+// Lock encodes the B data as a Bitcom data script
+func (b *B) Lock() (*script.Script, error) {
+	proto, err := b.Protocol()
+	if err != nil {
+		return nil, err
+	}
+	return (&Bitcom{Protocols: []*BitcomProtocol{proto}}).Lock(), nil
+}
+
 // DecodeB processes and extracts B protocol data from a transaction script.
 // The function expects the script to contain protocol data in the format:
 // DATA MEDIA_TYPE ENCODING [FILENAME]
-// Where FILENAME is optional. Returns nil if the script is invalid or cannot be parsed.
+// Where FILENAME is optional. Empty MEDIA_TYPE and ENCODING pushes are treated
+// as absent and left empty. Data with a
+// gzip or base64 encoding is returned decoded. Returns nil if the script is
+// invalid or cannot be parsed.
 func DecodeB(data any) *B {
 	scr := ToScript(data)
 	if scr == nil {
@@ -64,13 +159,13 @@ func DecodeB(data any) *B {
 	if op, err = scr.ReadOp(&pos); err != nil {
 		return nil
 	}
-	b.MediaType = MediaType(op.Data)
+	b.MediaType = MediaType(bField(op.Data))
 
 	// Read ENCODING
 	if op, err = scr.ReadOp(&pos); err != nil {
 		return nil
 	}
-	b.Encoding = Encoding(op.Data)
+	b.Encoding = Encoding(bField(op.Data))
 
 	// Try to read optional FILENAME
 	if op, err = scr.ReadOp(&pos); err == nil {
@@ -78,5 +173,33 @@ func DecodeB(data any) *B {
 		b.Filename = string(op.Data)
 	}
 
+	switch b.normalizedEncoding() {
+	case EncodingGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(b.Data))
+		if err != nil {
+			return nil
+		}
+		decoded, err := io.ReadAll(io.LimitReader(zr, MaxDecodedSize+1))
+		if err != nil || len(decoded) > MaxDecodedSize {
+			return nil
+		}
+		b.Data = decoded
+	case EncodingBase64:
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b.Data)))
+		if err != nil {
+			return nil
+		}
+		b.Data = decoded
+	}
+
 	return b
 }
+
+// bField returns a media type or encoding push, treating one holding only
+// null bytes or spaces as empty
+func bField(data []byte) string {
+	if len(bytes.Trim(data, "\x00 ")) == 0 {
+		return ""
+	}
+	return string(data)
+}
@@ -184,3 +184,80 @@ func TestDecodeB_Bytes(t *testing.T) {
 	result = DecodeB(invalidBytes)
 	require.Nil(t, result, "Expected nil result for invalid script bytes")
 }
+
+// TestBProtocol tests encoding B data and decoding it back
+func TestBProtocol(t *testing.T) {
+	resetTestState()
+
+	content := []byte(strings.Repeat("Hello BSV ", 20))
+	for _, encoding := range []Encoding{EncodingUTF8, EncodingBinary, EncodingGzip, EncodingBase64} {
+		t.Run(string(encoding), func(t *testing.T) {
+			b := &B{
+				MediaType: MediaTypeTextPlain,
+				Encoding:  encoding,
+				Data:      content,
+				Filename:  "hello.txt",
+			}
+			locked, err := b.Lock()
+			require.NoError(t, err)
+
+			bc := Decode(locked)
+			require.NotNil(t, bc)
+			require.Len(t, bc.Protocols, 1)
+			require.Equal(t, BPrefix, bc.Protocols[0].Protocol)
+
+			decoded := DecodeB(bc.Protocols[0].Script)
+			require.NotNil(t, decoded)
+			require.Equal(t, b, decoded)
+		})
+	}
+
+	t.Run("empty media type and encoding", func(t *testing.T) {
+		proto, err := (&B{Data: []byte{0x01, 0x02}}).Protocol()
+		require.NoError(t, err)
+		expected := &script.Script{}
+		_ = expected.AppendPushData([]byte{0x01, 0x02})
+		_ = expected.AppendOpcodes(script.Op0, script.Op0)
+		require.Equal(t, []byte(*expected), proto.Script)
+
+		s := &script.Script{}
+		_ = s.AppendPushData([]byte("data"))
+		_ = s.AppendPushData([]byte{0x00})
+		_ = s.AppendPushData([]byte(" "))
+		decoded := DecodeB(s)
+		require.NotNil(t, decoded)
+		require.Empty(t, decoded.MediaType)
+		require.Empty(t, decoded.Encoding)
+		require.Equal(t, "data", string(decoded.Data))
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := (&B{Encoding: EncodingUTF8, Data: []byte{0xff, 0xfe}}).Protocol()
+		require.ErrorIs(t, err, ErrEncodingMismatch)
+		_, err = (&B{Encoding: "rot13", Data: content}).Lock()
+		require.ErrorIs(t, err, ErrUnsupportedEncoding)
+
+		for _, encoding := range []Encoding{EncodingGzip, EncodingBase64} {
+			s := &script.Script{}
+			_ = s.AppendPushData([]byte("not encoded!"))
+			_ = s.AppendPushDataString(string(MediaTypeTextPlain))
+			_ = s.AppendPushDataString(string(encoding))
+			require.Nil(t, DecodeB(s), string(encoding))
+		}
+	})
+
+	t.Run("decompressed size limit", func(t *testing.T) {
+		for size, ok := range map[int]bool{MaxDecodedSize: true, MaxDecodedSize + 1: false} {
+			proto, err := (&B{Encoding: EncodingGzip, Data: make([]byte, size)}).Protocol()
+			require.NoError(t, err)
+			require.Less(t, len(proto.Script), size/100, "zeros should compress well")
+			decoded := DecodeB(proto.Script)
+			if ok {
+				require.NotNil(t, decoded)
+				require.Len(t, decoded.Data, size)
+			} else {
+				require.Nil(t, decoded)
+			}
+		}
+	})
+}
@@ -2,6 +2,10 @@ package bitcom
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"maps"
+	"sort"
 	"strings"
 
 	"github.com/bsv-blockchain/go-sdk/script"
@@ -18,12 +22,161 @@ var (
 	MapCmdDel    MapCmd = "DEL"
 	MapCmdAdd    MapCmd = "ADD"
 	MapCmdSelect MapCmd = "SELECT"
+	MapCmdRemove MapCmd = "REMOVE"
+	MapCmdJSON   MapCmd = "JSON"
 )
 
+var (
+	ErrUnsupportedMapCmd = errors.New("unsupported MAP command")
+	ErrEmptyMap          = errors.New("MAP command has no data")
+	ErrMissingMapKey     = errors.New("MAP ADD requires a key")
+	ErrMissingMapTxid    = errors.New("MAP SELECT requires a txid and a command")
+)
+
+// MapPair is a MAP key and value as they appear on chain
+type MapPair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Map is a MAP command. SET and DEL use Data and Pairs, ADD uses Key and
+// Adds, REMOVE uses Keys, SELECT applies Select to the transaction Txid, and
+// JSON holds an object whose members are also decoded into Data. When Data no
+// longer matches Pairs, for instance after being edited, SET and DEL are
+// encoded from Data and Pairs is ignored.
 type Map struct {
-	Cmd  MapCmd            `json:"cmd"`
-	Data map[string]string `json:"data"`
-	Adds []string          `json:"adds,omitempty"`
+	Cmd    MapCmd            `json:"cmd"`
+	Data   map[string]string `json:"data"`             // Last value of each key
+	Pairs  []MapPair         `json:"pairs,omitempty"`  // Key/value pairs in script order, including repeated keys
+	Key    string            `json:"key,omitempty"`    // Key of the list values are added to by ADD
+	Adds   []string          `json:"adds,omitempty"`   // Values added by ADD
+	Keys   []string          `json:"keys,omitempty"`   // Keys removed by REMOVE
+	Txid   string            `json:"txid,omitempty"`   // Transaction selected by SELECT
+	Select *Map              `json:"select,omitempty"` // Command applied to the selected transaction
+	JSON   string            `json:"json,omitempty"`   // Object written by JSON
+}
+
+// mapKeyOrder lists keys written ahead of all others, which follow sorted
+var mapKeyOrder = []string{"app", "type"}
+
+// Protocol encodes the MAP command as a Bitcom protocol. SET and DEL write
+// Pairs in order, or if Pairs is empty or does not match Data, the key/value
+// pairs in Data with "app" and "type" first and the remaining keys sorted, so
+// the same Map always produces the same bytes. ADD writes Key followed by Adds, REMOVE
+// writes Keys, SELECT writes Txid followed by the Select command, and JSON
+// writes the JSON field or, if it is empty, Data as a JSON object.
+func (m *Map) Protocol() (*BitcomProtocol, error) {
+	s := &script.Script{}
+	if err := m.appendCmd(s); err != nil {
+		return nil, err
+	}
+	return &BitcomProtocol{
+		Protocol: MapPrefix,
+		Script:   *s,
+	}, nil
+}
+
+// appendCmd appends the command and its arguments to s
+func (m *Map) appendCmd(s *script.Script) error {
+	_ = s.AppendPushDataString(string(m.Cmd))
+	switch m.Cmd {
+	case MapCmdSet, MapCmdDel:
+		pairs := m.pairs()
+		if len(pairs) == 0 {
+			return ErrEmptyMap
+		}
+		for _, pair := range pairs {
+			_ = s.AppendPushDataString(pair.Key)
+			_ = s.AppendPushDataString(pair.Value)
+		}
+	case MapCmdAdd:
+		if m.Key == "" {
+			return ErrMissingMapKey
+		} else if len(m.Adds) == 0 {
+			return ErrEmptyMap
+		}
+		_ = s.AppendPushDataString(m.Key)
+		for _, value := range m.Adds {
+			_ = s.AppendPushDataString(value)
+		}
+	case MapCmdRemove:
+		if len(m.Keys) == 0 {
+			return ErrEmptyMap
+		}
+		for _, key := range m.Keys {
+			_ = s.AppendPushDataString(key)
+		}
+	case MapCmdSelect:
+		if m.Txid == "" || m.Select == nil {
+			return ErrMissingMapTxid
+		}
+		_ = s.AppendPushDataString(m.Txid)
+		return m.Select.appendCmd(s)
+	case MapCmdJSON:
+		obj := m.JSON
+		if obj == "" {
+			if len(m.Data) == 0 {
+				return ErrEmptyMap
+			}
+			data, err := json.Marshal(m.Data)
+			if err != nil {
+				return err
+			}
+			obj = string(data)
+		}
+		_ = s.AppendPushDataString(obj)
+	default:
+		return ErrUnsupportedMapCmd
+	}
+	return nil
+}
+
+// Lock encodes the MAP command as a Bitcom data script. A Map returned by
+// DecodeMap re-encodes to the script it was decoded from, provided that used
+// minimal pushes.
+func (m *Map) Lock() (*script.Script, error) {
+	proto, err := m.Protocol()
+	if err != nil {
+		return nil, err
+	}
+	return (&Bitcom{Protocols: []*BitcomProtocol{proto}}).Lock(), nil
+}
+
+// pairs returns the key/value pairs of a SET or DEL: Pairs if it matches
+// Data, otherwise Data in sorted key order
+func (m *Map) pairs() []MapPair {
+	if len(m.Pairs) > 0 {
+		data := make(map[string]string, len(m.Data))
+		for _, pair := range m.Pairs {
+			data[cleanMapString([]byte(pair.Key))] = cleanMapString([]byte(pair.Value))
+		}
+		if maps.Equal(data, m.Data) {
+			return m.Pairs
+		}
+	}
+	pairs := make([]MapPair, 0, len(m.Data))
+	for _, key := range m.sortedKeys() {
+		pairs = append(pairs, MapPair{Key: key, Value: m.Data[key]})
+	}
+	return pairs
+}
+
+// sortedKeys returns the keys of Data in the order they are written
+func (m *Map) sortedKeys() []string {
+	keys := make([]string, 0, len(m.Data))
+	for _, key := range mapKeyOrder {
+		if _, ok := m.Data[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	rest := make([]string, 0, len(m.Data))
+	for key := range m.Data {
+		if key != "app" && key != "type" {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	return append(keys, rest...)
 }
 
 // DecodeMap decodes the map data from the transaction script
@@ -34,16 +187,20 @@ func DecodeMap(data any) *Map {
 	}
 
 	pos := ZERO
-	var op *script.ScriptChunk
-	var err error
 
 	// If length is < minimum, return nil
 	if len(*scr) < 6 {
 		return nil
 	}
+	return decodeMapCmd(scr, &pos)
+}
 
+// decodeMapCmd decodes the MAP command at pos and its arguments, recursing
+// into the command selected by SELECT
+func decodeMapCmd(scr *script.Script, pos *int) *Map {
 	// Read command
-	if op, err = scr.ReadOp(&pos); err != nil {
+	op, err := scr.ReadOp(pos)
+	if err != nil {
 		return nil
 	}
 	cmd := MapCmd(op.Data)
@@ -54,32 +211,80 @@ func DecodeMap(data any) *Map {
 		Data: make(map[string]string),
 	}
 
-	// Handle SET command
-	if cmd == MapCmdSet {
+	switch cmd {
+	case MapCmdSet, MapCmdDel:
 		for {
 			// Save position to revert if needed
-			keyPos := pos
+			keyPos := *pos
 
 			// Try to read key
-			if op, err = scr.ReadOp(&pos); err != nil {
+			if op, err = scr.ReadOp(pos); err != nil {
 				break
 			}
-			opKey := strings.ReplaceAll(string(bytes.ReplaceAll(op.Data, []byte{0}, []byte{' '})), "\\u0000", " ")
+			keyData := op.Data
 
 			// Try to read value
-			if op, err = scr.ReadOp(&pos); err != nil {
+			if op, err = scr.ReadOp(pos); err != nil {
 				// Couldn't read value, revert to position before key and break
-				pos = keyPos
+				*pos = keyPos
 				break
 			}
 
-			// Clean up value, replacing invalid UTF-8 sequences with spaces
+			// Clean up key and value, replacing null bytes with spaces
 			// rather than skipping the entire key-value pair
-			cleanValue := strings.ReplaceAll(string(bytes.ReplaceAll(op.Data, []byte{0}, []byte{' '})), "\\u0000", " ")
-
-			m.Data[opKey] = cleanValue
+			m.Data[cleanMapString(keyData)] = cleanMapString(op.Data)
+			m.Pairs = append(m.Pairs, MapPair{Key: string(keyData), Value: string(op.Data)})
+		}
+	case MapCmdAdd:
+		if op, err = scr.ReadOp(pos); err != nil {
+			return m
+		}
+		m.Key = cleanMapString(op.Data)
+		for {
+			if op, err = scr.ReadOp(pos); err != nil {
+				break
+			}
+			m.Adds = append(m.Adds, cleanMapString(op.Data))
+		}
+	case MapCmdRemove:
+		for {
+			if op, err = scr.ReadOp(pos); err != nil {
+				break
+			}
+			m.Keys = append(m.Keys, cleanMapString(op.Data))
+		}
+	case MapCmdSelect:
+		if op, err = scr.ReadOp(pos); err != nil {
+			return nil
+		}
+		m.Txid = string(op.Data)
+		if m.Select = decodeMapCmd(scr, pos); m.Select == nil {
+			return nil
+		}
+	case MapCmdJSON:
+		if op, err = scr.ReadOp(pos); err != nil {
+			return nil
+		}
+		var obj map[string]json.RawMessage
+		if err = json.Unmarshal(op.Data, &obj); err != nil {
+			return nil
+		}
+		m.JSON = string(op.Data)
+		for key, raw := range obj {
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				// Non-string values are kept as their JSON text
+				value = string(raw)
+			}
+			m.Data[key] = value
 		}
 	}
 
 	return m
 }
+
+// cleanMapString converts pushed data to a string, replacing null bytes and
+// escaped nulls with spaces
+func cleanMapString(data []byte) string {
+	return strings.ReplaceAll(string(bytes.ReplaceAll(data, []byte{0}, []byte{' '})), "\\u0000", " ")
+}
@@ -262,3 +262,153 @@ func TestToScript(t *testing.T) {
 	require.NotNil(t, mapFromScript, "DecodeMap should work with script")
 	require.NotNil(t, mapFromBytes, "DecodeMap should work with bytes")
 }
+
+func TestMapProtocol(t *testing.T) {
+	m := &Map{
+		Cmd:  MapCmdSet,
+		Data: map[string]string{"b": "2", "type": "post", "a": "1", "app": "test"},
+	}
+	proto, err := m.Protocol()
+	require.NoError(t, err)
+	require.Equal(t, MapPrefix, proto.Protocol)
+
+	expected := &script.Script{}
+	for _, push := range []string{"SET", "app", "test", "type", "post", "a", "1", "b", "2"} {
+		_ = expected.AppendPushDataString(push)
+	}
+	require.Equal(t, []byte(*expected), proto.Script)
+
+	_, err = (&Map{Cmd: MapCmdSet}).Protocol()
+	require.ErrorIs(t, err, ErrEmptyMap)
+}
+
+func TestMapRoundTrip(t *testing.T) {
+	resetTestState()
+
+	s := &script.Script{}
+	for _, push := range []string{"SET", "app", "test", "type", "like", "tx", "abc", "tx", "def", "emoji", "\x00"} {
+		_ = s.AppendPushDataString(push)
+	}
+	m := DecodeMap(s)
+	require.NotNil(t, m)
+	require.Equal(t, "def", m.Data["tx"])
+	require.Equal(t, " ", m.Data["emoji"])
+	require.Equal(t, []MapPair{
+		{Key: "app", Value: "test"},
+		{Key: "type", Value: "like"},
+		{Key: "tx", Value: "abc"},
+		{Key: "tx", Value: "def"},
+		{Key: "emoji", Value: "\x00"},
+	}, m.Pairs)
+
+	proto, err := m.Protocol()
+	require.NoError(t, err)
+	require.Equal(t, []byte(*s), proto.Script)
+
+	locked, err := m.Lock()
+	require.NoError(t, err)
+	bc := Decode(locked)
+	require.NotNil(t, bc)
+	require.Len(t, bc.Protocols, 1)
+	require.Equal(t, MapPrefix, bc.Protocols[0].Protocol)
+	require.Equal(t, m.Pairs, DecodeMap(bc.Protocols[0].Script).Pairs)
+
+	// Edits to Data after decoding are encoded rather than the original pairs
+	m.Data["type"] = "post"
+	delete(m.Data, "emoji")
+	proto, err = m.Protocol()
+	require.NoError(t, err)
+	expected := &script.Script{}
+	for _, push := range []string{"SET", "app", "test", "type", "post", "tx", "def"} {
+		_ = expected.AppendPushDataString(push)
+	}
+	require.Equal(t, []byte(*expected), proto.Script)
+
+	_, err = (&Map{Cmd: MapCmdSet}).Lock()
+	require.ErrorIs(t, err, ErrEmptyMap)
+}
+
+func TestMapCommands(t *testing.T) {
+	resetTestState()
+
+	pushes := func(data ...string) *script.Script {
+		s := &script.Script{}
+		for _, push := range data {
+			_ = s.AppendPushDataString(push)
+		}
+		return s
+	}
+	txid := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	t.Run("ADD", func(t *testing.T) {
+		s := pushes("ADD", "tags", "bsv", "test")
+		m := DecodeMap(s)
+		require.NotNil(t, m)
+		require.Equal(t, MapCmdAdd, m.Cmd)
+		require.Equal(t, "tags", m.Key)
+		require.Equal(t, []string{"bsv", "test"}, m.Adds)
+		proto, err := m.Protocol()
+		require.NoError(t, err)
+		require.Equal(t, []byte(*s), proto.Script)
+	})
+
+	t.Run("DEL", func(t *testing.T) {
+		s := pushes("DEL", "tags", "bsv", "tags", "test")
+		m := DecodeMap(s)
+		require.NotNil(t, m)
+		require.Equal(t, MapCmdDel, m.Cmd)
+		require.Equal(t, []MapPair{{Key: "tags", Value: "bsv"}, {Key: "tags", Value: "test"}}, m.Pairs)
+		proto, err := m.Protocol()
+		require.NoError(t, err)
+		require.Equal(t, []byte(*s), proto.Script)
+	})
+
+	t.Run("REMOVE", func(t *testing.T) {
+		s := pushes("REMOVE", "name", "avatar")
+		m := DecodeMap(s)
+		require.NotNil(t, m)
+		require.Equal(t, MapCmdRemove, m.Cmd)
+		require.Equal(t, []string{"name", "avatar"}, m.Keys)
+		proto, err := m.Protocol()
+		require.NoError(t, err)
+		require.Equal(t, []byte(*s), proto.Script)
+	})
+
+	t.Run("SELECT", func(t *testing.T) {
+		s := pushes("SELECT", txid, "SELECT", txid, "SET", "type", "like")
+		m := DecodeMap(s)
+		require.NotNil(t, m)
+		require.Equal(t, MapCmdSelect, m.Cmd)
+		require.Equal(t, txid, m.Txid)
+		require.NotNil(t, m.Select)
+		require.Equal(t, MapCmdSelect, m.Select.Cmd)
+		require.NotNil(t, m.Select.Select)
+		require.Equal(t, MapCmdSet, m.Select.Select.Cmd)
+		require.Equal(t, "like", m.Select.Select.Data["type"])
+		proto, err := m.Protocol()
+		require.NoError(t, err)
+		require.Equal(t, []byte(*s), proto.Script)
+
+		require.Nil(t, DecodeMap(pushes("SELECT", txid)))
+		_, err = (&Map{Cmd: MapCmdSelect, Txid: txid}).Protocol()
+		require.ErrorIs(t, err, ErrMissingMapTxid)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		s := pushes("JSON", `{"app":"test","count":3,"nested":{"a":true}}`)
+		m := DecodeMap(s)
+		require.NotNil(t, m)
+		require.Equal(t, MapCmdJSON, m.Cmd)
+		require.Equal(t, "test", m.Data["app"])
+		require.Equal(t, "3", m.Data["count"])
+		require.Equal(t, `{"a":true}`, m.Data["nested"])
+		proto, err := m.Protocol()
+		require.NoError(t, err)
+		require.Equal(t, []byte(*s), proto.Script)
+
+		require.Nil(t, DecodeMap(pushes("JSON", "not json")))
+		proto, err = (&Map{Cmd: MapCmdJSON, Data: map[string]string{"b": "2", "a": "1"}}).Protocol()
+		require.NoError(t, err)
+		require.Equal(t, []byte(*pushes("JSON", `{"a":"1","b":"2"}`)), proto.Script)
+	})
+}
@@ -0,0 +1,109 @@
+package bitcom
+
+import "slices"
+
+// MapUpdate is a MAP command applied to a target, with the transaction it
+// came from
+type MapUpdate struct {
+	Txid string `json:"txid"`
+	Map  *Map   `json:"map"`
+}
+
+// MapState is the key/value state of one MAP target, such as a txid or a BAP
+// id, built by replaying the commands applied to it
+type MapState struct {
+	Target  string              `json:"target"`
+	Values  map[string][]string `json:"values"`  // Values of each key, in the order they were set or added
+	History []MapUpdate         `json:"history"` // Commands applied to the target, oldest first
+}
+
+// Get returns the latest value of key, or "" if it has none
+func (s *MapState) Get(key string) string {
+	if values := s.Values[key]; len(values) > 0 {
+		return values[len(values)-1]
+	}
+	return ""
+}
+
+// Data returns the latest value of each key
+func (s *MapState) Data() map[string]string {
+	data := make(map[string]string, len(s.Values))
+	for key := range s.Values {
+		data[key] = s.Get(key)
+	}
+	return data
+}
+
+// MapReducer replays MAP commands into the state of each target they apply to
+type MapReducer struct {
+	States map[string]*MapState
+}
+
+// NewMapReducer returns a reducer with no state
+func NewMapReducer() *MapReducer {
+	return &MapReducer{
+		States: make(map[string]*MapState),
+	}
+}
+
+// State returns the state of target, or nil if no command has been applied
+// to it
+func (r *MapReducer) State(target string) *MapState {
+	return r.States[target]
+}
+
+// Apply applies m, found in transaction txid, to target. SET and JSON replace
+// the values of their keys, ADD appends to a key's values, DEL removes the
+// given values from their keys, and REMOVE deletes keys. A SELECT applies its
+// command to the selected transaction instead of target. Commands are applied
+// in the order given, so callers must supply them in transaction order. The
+// state is left unchanged if m cannot be applied.
+func (r *MapReducer) Apply(target, txid string, m *Map) error {
+	if m.Cmd == MapCmdSelect {
+		if m.Txid == "" || m.Select == nil {
+			return ErrMissingMapTxid
+		}
+		return r.Apply(m.Txid, txid, m.Select)
+	}
+
+	state := r.States[target]
+	if state == nil {
+		state = &MapState{
+			Target: target,
+			Values: make(map[string][]string),
+		}
+	}
+	switch m.Cmd {
+	case MapCmdSet, MapCmdJSON:
+		for key, value := range m.Data {
+			state.Values[key] = []string{value}
+		}
+	case MapCmdAdd:
+		if m.Key == "" {
+			return ErrMissingMapKey
+		}
+		state.Values[m.Key] = append(state.Values[m.Key], m.Adds...)
+	case MapCmdDel:
+		for _, pair := range m.pairs() {
+			key, value := cleanMapString([]byte(pair.Key)), cleanMapString([]byte(pair.Value))
+			values := slices.DeleteFunc(state.Values[key], func(v string) bool {
+				return v == value
+			})
+			if len(values) == 0 {
+				delete(state.Values, key)
+			} else {
+				state.Values[key] = values
+			}
+		}
+	case MapCmdRemove:
+		for _, key := range m.Keys {
+			delete(state.Values, key)
+		}
+	default:
+		return ErrUnsupportedMapCmd
+	}
+
+	state.History = append(state.History, MapUpdate{Txid: txid, Map: m})
+	r.States[target] = state
+	return nil
+}
@@ -0,0 +1,67 @@
+package bitcom
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/stretchr/testify/require"
+)
+
+func mapCmd(t *testing.T, data ...string) *Map {
+	t.Helper()
+	s := &script.Script{}
+	for _, push := range data {
+		_ = s.AppendPushDataString(push)
+	}
+	m := DecodeMap(s)
+	require.NotNil(t, m)
+	return m
+}
+
+func TestMapReducer(t *testing.T) {
+	resetTestState()
+
+	const bapID = "bap-id"
+	postTxid := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+
+	r := NewMapReducer()
+	require.Nil(t, r.State(bapID))
+
+	require.NoError(t, r.Apply(bapID, "tx1", mapCmd(t, "SET", "name", "Alice", "avatar", "a.png", "name", "Al")))
+	require.NoError(t, r.Apply(bapID, "tx2", mapCmd(t, "ADD", "tags", "bsv", "go", "test")))
+	require.NoError(t, r.Apply(bapID, "tx3", mapCmd(t, "DEL", "tags", "go")))
+	require.NoError(t, r.Apply(bapID, "tx4", mapCmd(t, "REMOVE", "avatar")))
+	require.NoError(t, r.Apply(bapID, "tx5", mapCmd(t, "SELECT", postTxid, "ADD", "likes", "tx5")))
+	require.NoError(t, r.Apply(bapID, "tx6", mapCmd(t, "JSON", `{"bio":"hi","age":30}`)))
+
+	state := r.State(bapID)
+	require.NotNil(t, state)
+	require.Equal(t, bapID, state.Target)
+	require.Equal(t, "Al", state.Get("name"))
+	require.Equal(t, []string{"bsv", "test"}, state.Values["tags"])
+	require.NotContains(t, state.Values, "avatar")
+	require.Equal(t, map[string]string{"name": "Al", "tags": "test", "bio": "hi", "age": "30"}, state.Data())
+
+	txids := make([]string, 0, len(state.History))
+	for _, update := range state.History {
+		txids = append(txids, update.Txid)
+	}
+	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4", "tx6"}, txids)
+
+	post := r.State(postTxid)
+	require.NotNil(t, post)
+	require.Equal(t, []string{"tx5"}, post.Values["likes"])
+	require.Len(t, post.History, 1)
+	require.Equal(t, MapCmdAdd, post.History[0].Map.Cmd)
+
+	// Deleting the last value of a key removes it
+	require.NoError(t, r.Apply(postTxid, "tx7", mapCmd(t, "DEL", "likes", "tx5")))
+	require.Empty(t, post.Values)
+
+	// Commands that cannot be applied leave the state unchanged
+	require.ErrorIs(t, r.Apply(bapID, "tx8", &Map{Cmd: MapCmdSelect}), ErrMissingMapTxid)
+	require.ErrorIs(t, r.Apply(bapID, "tx8", &Map{Cmd: MapCmdAdd}), ErrMissingMapKey)
+	require.ErrorIs(t, r.Apply("other", "tx8", &Map{Cmd: "UNKNOWN"}), ErrUnsupportedMapCmd)
+	require.Len(t, state.History, 5)
+	require.Nil(t, r.State("other"))
+}
@@ -168,6 +168,14 @@ func processProtocols(bc *bitcom.Bitcom, bsocial *BSocial) {
 
 // processMapData analyzes MAP data and populates the BSocial object
 func processMapData(m *bitcom.Map, bsocial *BSocial) {
+	// Tags are added to the post's "tags" list by a MAP ADD
+	if m.Cmd == bitcom.MapCmdAdd {
+		if m.Key == "tags" && len(m.Adds) > 0 {
+			processTags(bsocial, m.Adds)
+		}
+		return
+	}
+
 	// Check for tags in MAP data
 	if m.Data["app"] == AppName && m.Data["type"] == "post" {
 		// Try to extract tags if present
@@ -267,14 +275,9 @@ func CreatePost(post Post, attachments []bitcom.B, tags []string, identityKey *e
 	tx := transaction.NewTransaction()
 
 	// Create B protocol output first
-	s := &script.Script{}
-	_ = s.AppendOpcodes(script.OpFALSE, script.OpRETURN)
-	_ = s.AppendPushDataString(bitcom.BPrefix)
-	_ = s.AppendPushData(post.B.Data)
-	_ = s.AppendPushDataString(string(post.B.MediaType))
-	_ = s.AppendPushDataString(string(post.B.Encoding))
-	if post.B.Filename != "" {
-		_ = s.AppendPushDataString(post.B.Filename)
+	s, err := bScript(post.B)
+	if err != nil {
+		return nil, err
 	}
 
 	// Add MAP protocol
@@ -345,14 +348,9 @@ func CreateReply(reply Reply, replyTxID string, utxos []*transaction.UTXO, chang
 	tx := transaction.NewTransaction()
 
 	// Create B protocol output first
-	s := &script.Script{}
-	_ = s.AppendOpcodes(script.OpFALSE, script.OpRETURN)
-	_ = s.AppendPushDataString(bitcom.BPrefix)
-	_ = s.AppendPushData(reply.B.Data)
-	_ = s.AppendPushDataString(string(reply.B.MediaType))
-	_ = s.AppendPushDataString(string(reply.B.Encoding))
-	if reply.B.Filename != "" {
-		_ = s.AppendPushDataString(reply.B.Filename)
+	s, err := bScript(reply.B)
+	if err != nil {
+		return nil, err
 	}
 
 	tx.AddOutput(&transaction.TransactionOutput{
@@ -619,14 +617,9 @@ func CreateMessage(message Message, utxos []*transaction.UTXO, changeAddress *sc
 	tx := transaction.NewTransaction()
 
 	// Create B protocol output first
-	s := &script.Script{}
-	_ = s.AppendOpcodes(script.OpFALSE, script.OpRETURN)
-	_ = s.AppendPushDataString(bitcom.BPrefix)
-	_ = s.AppendPushData(message.B.Data)
-	_ = s.AppendPushDataString(string(message.B.MediaType))
-	_ = s.AppendPushDataString(string(message.B.Encoding))
-	if message.B.Filename != "" {
-		_ = s.AppendPushDataString(message.B.Filename)
+	s, err := bScript(message.B)
+	if err != nil {
+		return nil, err
 	}
 
 	tx.AddOutput(&transaction.TransactionOutput{
@@ -687,6 +680,19 @@ func CreateMessage(message Message, utxos []*transaction.UTXO, changeAddress *sc
 	return tx, nil
 }
 
+// bScript returns an OP_FALSE OP_RETURN script holding b, to which further
+// protocols may be appended
+func bScript(b bitcom.B) (*script.Script, error) {
+	proto, err := b.Protocol()
+	if err != nil {
+		return nil, err
+	}
+	return (&bitcom.Bitcom{
+		ScriptPrefix: []byte{script.OpFALSE},
+		Protocols:    []*bitcom.BitcomProtocol{proto},
+	}).Lock(), nil
+}
+
 // processTags handles different tag formats and adds them to the BSocial object
 func processTags(bsocial *BSocial, tagsField any) {
 	// Handle string
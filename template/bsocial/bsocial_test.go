@@ -50,7 +50,8 @@ func TestCreatePost(t *testing.T) {
 	require.Equal(t, string(post.B.MediaType), string(bsocial.Post.B.MediaType))
 	require.Equal(t, string(post.B.Encoding), string(bsocial.Post.B.Encoding))
 
-	// TODO: Add tag verification when the decoder properly handles tags
+	// Verify tags
+	require.Equal(t, [][]string{tags}, bsocial.Tags)
 }
 
 // TestCreateLike verifies the Like creation functionality
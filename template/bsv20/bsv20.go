@@ -1,11 +1,175 @@
 package bsv20
 
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+
+	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+)
+
+var (
+	ErrUnsupportedOp   = errors.New("unsupported bsv-20 operation")
+	ErrInvalidTicker   = errors.New("invalid ticker")
+	ErrInvalidMax      = errors.New("invalid max supply")
+	ErrInvalidDecimals = errors.New("invalid decimals")
+	ErrInvalidAmount   = errors.New("invalid token amount")
+)
+
+type Op string
+
+var (
+	OpDeploy   Op = "deploy"
+	OpMint     Op = "mint"
+	OpTransfer Op = "transfer"
+)
+
+const (
+	MaxTickLength = 4  // Maximum number of characters in a ticker
+	MaxDecimals   = 18 // Maximum number of decimal places
+)
+
 // Bsv20 represents a BSV20 token
 type Bsv20 struct {
-	Id       string  `json:"id,omitempty"`
-	Op       string  `json:"op"`
-	Ticker   string  `json:"tick,omitempty"`
-	Decimals uint8   `json:"dec"`
-	Icon     *string `json:"icon,omitempty"`
-	Amt      uint64  `json:"amt"`
+	Id       string                   `json:"id,omitempty"`
+	Op       string                   `json:"op"`
+	Ticker   string                   `json:"tick,omitempty"`
+	Max      uint64                   `json:"max,omitempty"`
+	Limit    uint64                   `json:"lim,omitempty"`
+	Decimals uint8                    `json:"dec"`
+	Icon     *string                  `json:"icon,omitempty"`
+	Amt      uint64                   `json:"amt"`
+	Insc     *inscription.Inscription `json:"-"`
+}
+
+// Decode returns the tick-based BSV-20 operation inscribed in scr, or nil if
+// scr holds no valid deploy, mint or transfer inscription
+func Decode(scr *script.Script) *Bsv20 {
+	insc := inscription.Decode(scr)
+	data := map[string]string{}
+	if insc == nil {
+		return nil
+	} else if insc.File.Type != "application/bsv-20" {
+		return nil
+	} else if err := json.Unmarshal(insc.File.Content, &data); err != nil {
+		return nil
+	} else if p, ok := data["p"]; !ok || p != "bsv-20" {
+		return nil
+	} else {
+		bsv20 := &Bsv20{
+			Insc: insc,
+		}
+		if op, ok := data["op"]; ok {
+			bsv20.Op = strings.ToLower(op)
+		} else {
+			return nil
+		}
+
+		if tick, ok := data["tick"]; !ok {
+			return nil
+		} else {
+			bsv20.Ticker = tick
+		}
+
+		switch bsv20.Op {
+		case string(OpDeploy):
+			if maxSupply, ok := data["max"]; !ok {
+				return nil
+			} else if bsv20.Max, err = strconv.ParseUint(maxSupply, 10, 64); err != nil {
+				return nil
+			}
+			if lim, ok := data["lim"]; ok {
+				if bsv20.Limit, err = strconv.ParseUint(lim, 10, 64); err != nil {
+					return nil
+				}
+			}
+			if dec, ok := data["dec"]; ok {
+				var val uint64
+				if val, err = strconv.ParseUint(dec, 10, 8); err != nil {
+					return nil
+				}
+				bsv20.Decimals = uint8(val)
+			}
+		case string(OpMint), string(OpTransfer):
+			if amt, ok := data["amt"]; !ok {
+				return nil
+			} else if bsv20.Amt, err = strconv.ParseUint(amt, 10, 64); err != nil {
+				return nil
+			}
+		}
+		if bsv20.Validate() != nil {
+			return nil
+		}
+		return bsv20
+	}
+}
+
+// Validate checks the rules a valid operation must follow: a ticker of 1 to
+// MaxTickLength characters, a non-zero max supply and at most MaxDecimals
+// decimals for a deploy, and a non-zero amount for a mint or transfer.
+func (b *Bsv20) Validate() error {
+	if length := utf8.RuneCountInString(b.Ticker); length == 0 || length > MaxTickLength {
+		return ErrInvalidTicker
+	}
+	switch b.Op {
+	case string(OpDeploy):
+		if b.Max == 0 {
+			return ErrInvalidMax
+		} else if b.Decimals > MaxDecimals {
+			return ErrInvalidDecimals
+		}
+	case string(OpMint), string(OpTransfer):
+		if b.Amt == 0 {
+			return ErrInvalidAmount
+		}
+	default:
+		return ErrUnsupportedOp
+	}
+	return nil
+}
+
+// Lock inscribes the operation in front of lockingScript. Only the fields
+// belonging to the operation are written, with numbers encoded as strings.
+// The operation is validated first, so Lock never writes an inscription
+// Decode would reject.
+func (b *Bsv20) Lock(lockingScript *script.Script) (*script.Script, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	data := map[string]string{
+		"p":    "bsv-20",
+		"op":   b.Op,
+		"tick": b.Ticker,
+	}
+	switch b.Op {
+	case string(OpDeploy):
+		data["max"] = strconv.FormatUint(b.Max, 10)
+		if b.Limit > 0 {
+			data["lim"] = strconv.FormatUint(b.Limit, 10)
+		}
+		if b.Decimals > 0 {
+			data["dec"] = strconv.FormatUint(uint64(b.Decimals), 10)
+		}
+	case string(OpMint), string(OpTransfer):
+		data["amt"] = strconv.FormatUint(b.Amt, 10)
+	}
+
+	if j, err := json.Marshal(data); err != nil {
+		return nil, err
+	} else {
+		insc := &inscription.Inscription{
+			File: inscription.File{
+				Content: j,
+				Type:    "application/bsv-20",
+			},
+		}
+		if lockingScript != nil {
+			insc.ScriptSuffix = *lockingScript
+		}
+		return insc.Lock()
+	}
 }
@@ -0,0 +1,127 @@
+package bsv20
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+)
+
+func inscribe(t *testing.T, contentType, content string) *script.Script {
+	t.Helper()
+	insc := &inscription.Inscription{
+		File: inscription.File{
+			Type:    contentType,
+			Content: []byte(content),
+		},
+	}
+	s, err := insc.Lock()
+	require.NoError(t, err)
+	return s
+}
+
+func TestDecodeDeploy(t *testing.T) {
+	b := Decode(inscribe(t, "application/bsv-20", `{"p":"bsv-20","op":"deploy","tick":"ordi","max":"21000000","lim":"1000","dec":"8"}`))
+	require.NotNil(t, b)
+	require.Equal(t, string(OpDeploy), b.Op)
+	require.Equal(t, "ordi", b.Ticker)
+	require.Equal(t, uint64(21000000), b.Max)
+	require.Equal(t, uint64(1000), b.Limit)
+	require.Equal(t, uint8(8), b.Decimals)
+	require.NotNil(t, b.Insc)
+}
+
+func TestDecodeMintTransfer(t *testing.T) {
+	b := Decode(inscribe(t, "application/bsv-20", `{"p":"bsv-20","op":"MINT","tick":"ordi","amt":"1000"}`))
+	require.NotNil(t, b)
+	require.Equal(t, string(OpMint), b.Op)
+	require.Equal(t, uint64(1000), b.Amt)
+
+	b = Decode(inscribe(t, "application/bsv-20", `{"p":"bsv-20","op":"transfer","tick":"😀","amt":"5"}`))
+	require.NotNil(t, b)
+	require.Equal(t, string(OpTransfer), b.Op)
+	require.Equal(t, "😀", b.Ticker)
+	require.Equal(t, uint64(5), b.Amt)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := map[string]string{
+		"tick too long":     `{"p":"bsv-20","op":"mint","tick":"ordin","amt":"1"}`,
+		"empty tick":        `{"p":"bsv-20","op":"mint","tick":"","amt":"1"}`,
+		"missing tick":      `{"p":"bsv-20","op":"transfer","id":"abc_0","amt":"1"}`,
+		"missing max":       `{"p":"bsv-20","op":"deploy","tick":"ordi"}`,
+		"zero max":          `{"p":"bsv-20","op":"deploy","tick":"ordi","max":"0"}`,
+		"bad lim":           `{"p":"bsv-20","op":"deploy","tick":"ordi","max":"10","lim":"-1"}`,
+		"dec too large":     `{"p":"bsv-20","op":"deploy","tick":"ordi","max":"10","dec":"19"}`,
+		"missing amt":       `{"p":"bsv-20","op":"mint","tick":"ordi"}`,
+		"zero amt":          `{"p":"bsv-20","op":"transfer","tick":"ordi","amt":"0"}`,
+		"numeric amt":       `{"p":"bsv-20","op":"mint","tick":"ordi","amt":1}`,
+		"unknown op":        `{"p":"bsv-20","op":"burn","tick":"ordi","amt":"1"}`,
+		"wrong protocol":    `{"p":"brc-20","op":"mint","tick":"ordi","amt":"1"}`,
+		"not json":          `mint ordi`,
+		"bsv-21 deploy":     `{"p":"bsv-20","op":"deploy+mint","sym":"ordi","amt":"1"}`,
+		"missing operation": `{"p":"bsv-20","tick":"ordi","amt":"1"}`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Nil(t, Decode(inscribe(t, "application/bsv-20", content)))
+		})
+	}
+
+	require.Nil(t, Decode(inscribe(t, "text/plain", `{"p":"bsv-20","op":"mint","tick":"ordi","amt":"1"}`)))
+	require.Nil(t, Decode(script.NewFromBytes([]byte{script.OpTRUE})))
+}
+
+func TestLockRoundTrip(t *testing.T) {
+	suffix, err := script.NewFromHex("76a914000000000000000000000000000000000000000088ac")
+	require.NoError(t, err)
+
+	tests := []*Bsv20{
+		{Op: string(OpDeploy), Ticker: "ordi", Max: 21000000, Limit: 1000, Decimals: 8},
+		{Op: string(OpDeploy), Ticker: "pepe", Max: 1},
+		{Op: string(OpMint), Ticker: "ordi", Amt: 1000},
+		{Op: string(OpTransfer), Ticker: "ordi", Amt: 42},
+	}
+	for _, token := range tests {
+		t.Run(token.Op, func(t *testing.T) {
+			s, err := token.Lock(suffix)
+			require.NoError(t, err)
+
+			decoded := Decode(s)
+			require.NotNil(t, decoded)
+			require.Equal(t, []byte(*suffix), decoded.Insc.ScriptSuffix)
+			decoded.Insc = nil
+			require.Equal(t, token, decoded)
+		})
+	}
+
+	s, err := (&Bsv20{Op: string(OpMint), Ticker: "ordi", Amt: 1}).Lock(suffix)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"p":"bsv-20","op":"mint","tick":"ordi","amt":"1"}`, string(Decode(s).Insc.File.Content))
+
+	_, err = (&Bsv20{Op: "burn", Ticker: "ordi", Amt: 1}).Lock(suffix)
+	require.ErrorIs(t, err, ErrUnsupportedOp)
+}
+
+// TestLockInvalid tests that Lock rejects operations Decode would not accept
+func TestLockInvalid(t *testing.T) {
+	tests := map[string]struct {
+		token *Bsv20
+		err   error
+	}{
+		"empty tick":    {&Bsv20{Op: string(OpMint), Amt: 1}, ErrInvalidTicker},
+		"tick too long": {&Bsv20{Op: string(OpMint), Ticker: "ordin", Amt: 1}, ErrInvalidTicker},
+		"zero max":      {&Bsv20{Op: string(OpDeploy), Ticker: "ordi"}, ErrInvalidMax},
+		"dec too large": {&Bsv20{Op: string(OpDeploy), Ticker: "ordi", Max: 10, Decimals: MaxDecimals + 1}, ErrInvalidDecimals},
+		"zero mint":     {&Bsv20{Op: string(OpMint), Ticker: "ordi"}, ErrInvalidAmount},
+		"zero transfer": {&Bsv20{Op: string(OpTransfer), Ticker: "ordi"}, ErrInvalidAmount},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.token.Lock(nil)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -1,13 +1,49 @@
 package bsv21
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"math/big"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/bsv-blockchain/go-sdk/transaction"
 
 	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
+)
+
+var (
+	ErrNoInscription      = errors.New("no inscription found")
+	ErrInvalidContentType = errors.New("content type is not application/bsv-20")
+	ErrInvalidJSON        = errors.New("invalid bsv-20 json")
+	ErrInvalidProtocol    = errors.New("protocol is not bsv-20")
+	ErrMissingOp          = errors.New("missing op")
+	ErrInvalidOp          = errors.New("invalid op")
+	ErrInvalidAmount      = errors.New("invalid token amount")
+	ErrMissingAmount      = errors.New("missing token amount")
+	ErrInvalidDecimals    = errors.New("invalid decimals")
+	ErrMissingId          = errors.New("missing token id")
+	ErrInvalidId          = errors.New("token id is not a valid outpoint")
+	ErrInvalidSymbol      = errors.New("invalid symbol")
+	ErrInvalidIcon        = errors.New("icon is not a valid outpoint")
+
+	ErrNotToken             = errors.New("utxo does not hold bsv-21 tokens")
+	ErrMixedTokens          = errors.New("utxos hold different tokens")
+	ErrNoTokenInputs        = errors.New("no token utxos supplied")
+	ErrInsufficientTokens   = errors.New("token inputs do not cover outputs")
+	ErrNoTokenChangeAddress = errors.New("token change address not supplied")
+	ErrInvalidRecipient     = errors.New("token recipient has no address")
+	ErrDeployNotFound       = errors.New("transaction has no output deploying the token")
+)
+
+const (
+	MaxDecimals     = 18 // Maximum number of decimal places
+	MaxSymbolLength = 32 // Maximum number of characters in a symbol
 )
 
 type Op string
@@ -24,21 +60,98 @@ type Bsv21 struct {
 	Symbol   *string                  `json:"sym,omitempty"`
 	Decimals *uint8                   `json:"dec,omitempty"`
 	Icon     *string                  `json:"icon,omitempty"`
-	Amt      uint64                   `json:"amt"`
+	Amt      *big.Int                 `json:"amt"` // Amount in the token's smallest unit
 	Insc     *inscription.Inscription `json:"-"`
 }
 
+// ParseAmount parses a protocol amount: a non-empty string of decimal digits
+// of any size.
+func ParseAmount(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, ErrInvalidAmount
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return nil, ErrInvalidAmount
+		}
+	}
+	amt, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+	return amt, nil
+}
+
+// MarshalJSON encodes Amt as a decimal string so amounts beyond the range of
+// a float64 survive a round trip.
+func (b Bsv21) MarshalJSON() ([]byte, error) {
+	type alias Bsv21
+	amt := "0"
+	if b.Amt != nil {
+		amt = b.Amt.String()
+	}
+	return json.Marshal(struct {
+		alias
+		Amt string `json:"amt"`
+	}{alias(b), amt})
+}
+
+// UnmarshalJSON accepts Amt either as a decimal string or as a JSON number.
+func (b *Bsv21) UnmarshalJSON(data []byte) error {
+	type alias Bsv21
+	aux := struct {
+		*alias
+		Amt json.RawMessage `json:"amt"`
+	}{alias: (*alias)(b)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	b.Amt = new(big.Int)
+	if len(aux.Amt) == 0 || string(aux.Amt) == "null" {
+		return nil
+	}
+	var amt string
+	if err := json.Unmarshal(aux.Amt, &amt); err != nil {
+		amt = string(aux.Amt)
+	}
+	var err error
+	b.Amt, err = ParseAmount(amt)
+	return err
+}
+
+// Decode returns the BSV-21 operation inscribed in scr, or nil if scr holds
+// no BSV-21 inscription. Use DecodeStrict to learn why an output was rejected.
 func Decode(scr *script.Script) *Bsv21 {
+	bsv21, _ := decode(scr)
+	if bsv21 != nil && bsv21.Amt == nil {
+		bsv21.Amt = new(big.Int)
+	}
+	return bsv21
+}
+
+// DecodeStrict decodes like Decode, additionally validating the amount, token
+// id, symbol and icon. The returned error identifies the rule that failed.
+func DecodeStrict(scr *script.Script) (*Bsv21, error) {
+	bsv21, err := decode(scr)
+	if err != nil {
+		return nil, err
+	} else if err = bsv21.Validate(); err != nil {
+		return nil, err
+	}
+	return bsv21, nil
+}
+
+func decode(scr *script.Script) (*Bsv21, error) {
 	insc := inscription.Decode(scr)
 	data := map[string]string{}
 	if insc == nil {
-		return nil
+		return nil, ErrNoInscription
 	} else if insc.File.Type != "application/bsv-20" {
-		return nil
+		return nil, ErrInvalidContentType
 	} else if err := json.Unmarshal(insc.File.Content, &data); err != nil {
-		return nil
+		return nil, ErrInvalidJSON
 	} else if p, ok := data["p"]; !ok || p != "bsv-20" {
-		return nil
+		return nil, ErrInvalidProtocol
 	} else {
 		bsv21 := &Bsv21{
 			Insc: insc,
@@ -46,19 +159,19 @@ func Decode(scr *script.Script) *Bsv21 {
 		if op, ok := data["op"]; ok {
 			bsv21.Op = strings.ToLower(op)
 		} else {
-			return nil
+			return nil, ErrMissingOp
 		}
 
 		if amt, ok := data["amt"]; ok {
-			if bsv21.Amt, err = strconv.ParseUint(amt, 10, 64); err != nil {
-				return nil
+			if bsv21.Amt, err = ParseAmount(amt); err != nil {
+				return nil, err
 			}
 		}
 
 		if dec, ok := data["dec"]; ok {
 			var val uint64
-			if val, err = strconv.ParseUint(dec, 10, 8); err != nil || val > 18 {
-				return nil
+			if val, err = strconv.ParseUint(dec, 10, 8); err != nil || val > MaxDecimals {
+				return nil, ErrInvalidDecimals
 			}
 			decimals := uint8(val)
 			bsv21.Decimals = &decimals
@@ -74,19 +187,112 @@ func Decode(scr *script.Script) *Bsv21 {
 			}
 		case string(OpTransfer), string(OpBurn):
 			if id, ok := data["id"]; !ok {
-				return nil
+				return nil, ErrMissingId
 			} else {
 				bsv21.Id = id
 			}
 		default:
-			return nil
+			return nil, ErrInvalidOp
 		}
-		return bsv21
+		return bsv21, nil
 	}
 }
 
+// Validate checks the fields a decoded operation does not: the amount must be
+// present and positive, the token id an outpoint, the symbol at most
+// MaxSymbolLength characters and the icon an outpoint or an output of the
+// deploying transaction ("_<vout>").
+func (b *Bsv21) Validate() error {
+	if b.Amt == nil {
+		return ErrMissingAmount
+	} else if b.Amt.Sign() <= 0 {
+		return ErrInvalidAmount
+	}
+	switch b.Op {
+	case string(OpMint):
+		if b.Symbol != nil {
+			if length := utf8.RuneCountInString(*b.Symbol); length == 0 || length > MaxSymbolLength {
+				return ErrInvalidSymbol
+			}
+		}
+		if b.Icon != nil && !isOutpoint(*b.Icon) && !isVoutRef(*b.Icon) {
+			return ErrInvalidIcon
+		}
+	case string(OpTransfer), string(OpBurn):
+		if b.Id == "" {
+			return ErrMissingId
+		} else if !isOutpoint(b.Id) {
+			return ErrInvalidId
+		}
+	default:
+		return ErrInvalidOp
+	}
+	if b.Decimals != nil && *b.Decimals > MaxDecimals {
+		return ErrInvalidDecimals
+	}
+	return nil
+}
+
+// isOutpoint reports whether s is formatted as <txid>_<vout>
+func isOutpoint(s string) bool {
+	if len(s) < 66 || s[64] != '_' {
+		return false
+	} else if _, err := hex.DecodeString(s[:64]); err != nil {
+		return false
+	}
+	return isVoutRef(s[64:])
+}
+
+// isVoutRef reports whether s is formatted as _<vout>
+func isVoutRef(s string) bool {
+	if len(s) < 2 || s[0] != '_' {
+		return false
+	}
+	_, err := strconv.ParseUint(s[1:], 10, 32)
+	return err == nil
+}
+
+// Lock inscribes the operation in front of lockingScript using the protocol's
+// canonical JSON: the "p" and "op" fields followed by the fields belonging to
+// the operation, with numbers encoded as strings.
 func (b *Bsv21) Lock(lockingScript *script.Script) (*script.Script, error) {
-	if j, err := json.Marshal(b); err != nil {
+	data := struct {
+		P    string `json:"p"`
+		Op   string `json:"op"`
+		Id   string `json:"id,omitempty"`
+		Sym  string `json:"sym,omitempty"`
+		Amt  string `json:"amt"`
+		Dec  string `json:"dec,omitempty"`
+		Icon string `json:"icon,omitempty"`
+	}{
+		P:   "bsv-20",
+		Op:  b.Op,
+		Amt: "0",
+	}
+	if b.Amt != nil {
+		data.Amt = b.Amt.String()
+	}
+	switch b.Op {
+	case string(OpMint):
+		if b.Symbol != nil {
+			data.Sym = *b.Symbol
+		}
+		if b.Decimals != nil {
+			data.Dec = strconv.FormatUint(uint64(*b.Decimals), 10)
+		}
+		if b.Icon != nil {
+			data.Icon = *b.Icon
+		}
+	case string(OpTransfer), string(OpBurn):
+		if b.Id == "" {
+			return nil, ErrMissingId
+		}
+		data.Id = b.Id
+	default:
+		return nil, ErrInvalidOp
+	}
+
+	if j, err := json.Marshal(data); err != nil {
 		return nil, err
 	} else {
 		insc := &inscription.Inscription{
@@ -94,8 +300,175 @@ func (b *Bsv21) Lock(lockingScript *script.Script) (*script.Script, error) {
 				Content: j,
 				Type:    "application/bsv-20",
 			},
-			ScriptSuffix: *lockingScript,
+		}
+		if lockingScript != nil {
+			insc.ScriptSuffix = *lockingScript
 		}
 		return insc.Lock()
 	}
 }
+
+// DeployMint is a deploy+mint output to be added to a transaction
+type DeployMint struct {
+	Token         *Bsv21
+	LockingScript *script.Script
+}
+
+// NewDeployMint creates the deploy+mint output minting the entire supply amt
+// of a token in front of lockingScript. icon is optional and refers to an
+// inscription by outpoint, or as "_<vout>" to an output of the deploying
+// transaction itself.
+func NewDeployMint(symbol string, decimals uint8, amt *big.Int, icon string, lockingScript *script.Script) (*DeployMint, error) {
+	if amt == nil || amt.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	token := &Bsv21{
+		Op:       string(OpMint),
+		Symbol:   &symbol,
+		Decimals: &decimals,
+		Amt:      amt,
+	}
+	if icon != "" {
+		token.Icon = &icon
+	}
+	if err := token.Validate(); err != nil {
+		return nil, err
+	}
+	s, err := token.Lock(lockingScript)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployMint{
+		Token:         token,
+		LockingScript: s,
+	}, nil
+}
+
+// TokenId returns the id of the deployed token: the outpoint of the output in
+// tx carrying LockingScript. The txid changes as inputs are signed, so call
+// TokenId on the signed transaction.
+func (d *DeployMint) TokenId(tx *transaction.Transaction) (string, error) {
+	for vout, output := range tx.Outputs {
+		if output.LockingScript != nil && bytes.Equal(*output.LockingScript, *d.LockingScript) {
+			return (&transaction.Outpoint{
+				Txid:  *tx.TxID(),
+				Index: uint32(vout), //nolint:gosec // G115: output counts fit in uint32
+			}).OrdinalString(), nil
+		}
+	}
+	return "", ErrDeployNotFound
+}
+
+// TokenRecipient is an amount of tokens to transfer to an address
+type TokenRecipient struct {
+	Address *script.Address
+	Amt     *big.Int
+}
+
+// BuildTransferTx builds an unsigned transaction spending tokenUTXOs, which
+// must all hold the same token and carry their own unlocking templates. Each
+// recipient receives a 1 satoshi transfer output. burnAmt tokens, if not nil,
+// are destroyed in a burn output locked to tokenChangeAddress, and any tokens
+// left over are returned to tokenChangeAddress. paymentUTXOs fund the
+// transaction and satoshi change goes to changeAddress. Call Fee and Sign
+// before broadcasting.
+func BuildTransferTx(tokenUTXOs []*transaction.UTXO, recipients []*TokenRecipient, tokenChangeAddress *script.Address, burnAmt *big.Int, paymentUTXOs []*transaction.UTXO, changeAddress *script.Address) (*transaction.Transaction, error) {
+	if len(tokenUTXOs) == 0 {
+		return nil, ErrNoTokenInputs
+	}
+	for _, recipient := range recipients {
+		if recipient == nil || recipient.Address == nil {
+			return nil, ErrInvalidRecipient
+		}
+	}
+	var id string
+	balance := new(big.Int)
+	for _, utxo := range tokenUTXOs {
+		if utxo == nil || utxo.LockingScript == nil {
+			return nil, ErrNotToken
+		}
+		token := Decode(utxo.LockingScript)
+		if token == nil {
+			return nil, ErrNotToken
+		}
+		tokenId := token.Id
+		switch token.Op {
+		case string(OpMint):
+			tokenId = (&transaction.Outpoint{Txid: *utxo.TxID, Index: utxo.Vout}).OrdinalString()
+		case string(OpTransfer):
+		default:
+			return nil, ErrNotToken
+		}
+		if id == "" {
+			id = tokenId
+		} else if tokenId != id {
+			return nil, ErrMixedTokens
+		}
+		balance.Add(balance, token.Amt)
+	}
+
+	tx := transaction.NewTransaction()
+	if err := tx.AddInputsFromUTXOs(tokenUTXOs...); err != nil {
+		return nil, err
+	} else if err = tx.AddInputsFromUTXOs(paymentUTXOs...); err != nil {
+		return nil, err
+	}
+
+	addTokenOutput := func(op Op, address *script.Address, amt *big.Int) error {
+		if amt == nil || amt.Sign() <= 0 {
+			return ErrInvalidAmount
+		} else if balance.Cmp(amt) < 0 {
+			return ErrInsufficientTokens
+		}
+		balance.Sub(balance, amt)
+		lockingScript, err := p2pkh.Lock(address)
+		if err != nil {
+			return err
+		}
+		token := &Bsv21{
+			Id:  id,
+			Op:  string(op),
+			Amt: amt,
+		}
+		if lockingScript, err = token.Lock(lockingScript); err != nil {
+			return err
+		}
+		tx.AddOutput(&transaction.TransactionOutput{
+			LockingScript: lockingScript,
+			Satoshis:      1,
+		})
+		return nil
+	}
+
+	for _, recipient := range recipients {
+		if err := addTokenOutput(OpTransfer, recipient.Address, recipient.Amt); err != nil {
+			return nil, err
+		}
+	}
+	if burnAmt != nil {
+		if tokenChangeAddress == nil {
+			return nil, ErrNoTokenChangeAddress
+		} else if err := addTokenOutput(OpBurn, tokenChangeAddress, burnAmt); err != nil {
+			return nil, err
+		}
+	}
+	if balance.Sign() > 0 {
+		if tokenChangeAddress == nil {
+			return nil, ErrNoTokenChangeAddress
+		} else if err := addTokenOutput(OpTransfer, tokenChangeAddress, new(big.Int).Set(balance)); err != nil {
+			return nil, err
+		}
+	}
+	if changeAddress != nil {
+		change := &transaction.TransactionOutput{
+			Change: true,
+		}
+		var err error
+		if change.LockingScript, err = p2pkh.Lock(changeAddress); err != nil {
+			return nil, err
+		}
+		tx.AddOutput(change)
+	}
+
+	return tx, nil
+}
@@ -1,12 +1,21 @@
 package bsv21
 
 import (
+	"encoding/json"
+	"math/big"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction"
+	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
 )
 
 // TestDecodeBSV21 tests decoding a BSV21 token from a test vector
@@ -44,7 +53,7 @@ func TestDecodeBSV21(t *testing.T) {
 	// Verify BSV21 data
 	t.Logf("BSV21 data: Op=%s, Amt=%d", bsv21Data.Op, bsv21Data.Amt)
 	require.Equal(t, "deploy+mint", bsv21Data.Op, "Operation should be deploy+mint")
-	require.Equal(t, uint64(4200000000), bsv21Data.Amt, "Amount should be 4200000000")
+	require.Equal(t, uint64(4200000000), bsv21Data.Amt.Uint64(), "Amount should be 4200000000")
 
 	// Check the Symbol (sym)
 	require.NotNil(t, bsv21Data.Symbol, "Symbol should not be nil")
@@ -62,3 +71,308 @@ func TestDecodeBSV21(t *testing.T) {
 	require.NotNil(t, bsv21Data.Insc, "Inscription should not be nil")
 	require.Equal(t, "application/bsv-20", bsv21Data.Insc.File.Type, "File type should be application/bsv-20")
 }
+
+func inscribe(t *testing.T, content string) *script.Script {
+	s, err := (&inscription.Inscription{
+		File: inscription.File{
+			Type:    "application/bsv-20",
+			Content: []byte(content),
+		},
+	}).Lock()
+	require.NoError(t, err)
+	return s
+}
+
+// TestDecodeLargeAmount tests amounts that do not fit in a uint64
+func TestDecodeLargeAmount(t *testing.T) {
+	const amt = "21000000000000000000000000"
+	b := Decode(inscribe(t, `{"p":"bsv-20","op":"deploy+mint","sym":"BIG","amt":"`+amt+`","dec":"18"}`))
+	require.NotNil(t, b)
+	require.Equal(t, amt, b.Amt.String())
+	require.False(t, b.Amt.IsUint64())
+
+	for _, bad := range []string{"", "-1", "+1", "1.5", "1e18", " 1"} {
+		require.Nil(t, Decode(inscribe(t, `{"p":"bsv-20","op":"deploy+mint","sym":"BIG","amt":"`+bad+`"}`)), bad)
+	}
+
+	b = Decode(inscribe(t, `{"p":"bsv-20","op":"transfer","id":"abc_0"}`))
+	require.NotNil(t, b)
+	require.Zero(t, b.Amt.Sign())
+}
+
+// TestAmountJSONRoundTrip tests that amounts survive JSON encoding exactly
+func TestAmountJSONRoundTrip(t *testing.T) {
+	amt, ok := new(big.Int).SetString("340282366920938463463374607431768211457", 10)
+	require.True(t, ok)
+	b := &Bsv21{Id: "abc_0", Op: string(OpTransfer), Amt: amt}
+
+	j, err := json.Marshal(b)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"abc_0","op":"transfer","amt":"340282366920938463463374607431768211457"}`, string(j))
+
+	decoded := &Bsv21{}
+	require.NoError(t, json.Unmarshal(j, decoded))
+	require.Equal(t, 0, amt.Cmp(decoded.Amt))
+	require.Equal(t, "abc_0", decoded.Id)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"op":"transfer","amt":1000}`), decoded))
+	require.Equal(t, uint64(1000), decoded.Amt.Uint64())
+	require.ErrorIs(t, json.Unmarshal([]byte(`{"op":"transfer","amt":"-1"}`), decoded), ErrInvalidAmount)
+}
+
+// TestDecodeStrict tests that each rejected output reports the rule it broke
+func TestDecodeStrict(t *testing.T) {
+	const txid = "dfa24771dbd093efbddf19ec424eab60113e288672c23182be75ec3f5452ba8d"
+	b, err := DecodeStrict(inscribe(t, `{"p":"bsv-20","op":"deploy+mint","sym":"BUIDL","amt":"100","dec":"2","icon":"`+txid+`_1"}`))
+	require.NoError(t, err)
+	require.Equal(t, "BUIDL", *b.Symbol)
+
+	_, err = DecodeStrict(inscribe(t, `{"p":"bsv-20","op":"deploy+mint","sym":"BUIDL","amt":"100","icon":"_1"}`))
+	require.NoError(t, err)
+	_, err = DecodeStrict(inscribe(t, `{"p":"bsv-20","op":"transfer","id":"`+txid+`_0","amt":"100"}`))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		content string
+		err     error
+	}{
+		"bad json":         {`{"p":"bsv-20"`, ErrInvalidJSON},
+		"wrong protocol":   {`{"p":"brc-20","op":"transfer"}`, ErrInvalidProtocol},
+		"missing op":       {`{"p":"bsv-20","amt":"1"}`, ErrMissingOp},
+		"unknown op":       {`{"p":"bsv-20","op":"deploy","amt":"1"}`, ErrInvalidOp},
+		"bad amount":       {`{"p":"bsv-20","op":"transfer","id":"` + txid + `_0","amt":"-1"}`, ErrInvalidAmount},
+		"missing amount":   {`{"p":"bsv-20","op":"transfer","id":"` + txid + `_0"}`, ErrMissingAmount},
+		"zero transfer":    {`{"p":"bsv-20","op":"transfer","id":"` + txid + `_0","amt":"0"}`, ErrInvalidAmount},
+		"zero burn":        {`{"p":"bsv-20","op":"burn","id":"` + txid + `_0","amt":"0"}`, ErrInvalidAmount},
+		"zero supply":      {`{"p":"bsv-20","op":"deploy+mint","amt":"0"}`, ErrInvalidAmount},
+		"bad decimals":     {`{"p":"bsv-20","op":"deploy+mint","amt":"1","dec":"19"}`, ErrInvalidDecimals},
+		"missing id":       {`{"p":"bsv-20","op":"burn","amt":"1"}`, ErrMissingId},
+		"short id":         {`{"p":"bsv-20","op":"transfer","id":"abc_0","amt":"1"}`, ErrInvalidId},
+		"id without vout":  {`{"p":"bsv-20","op":"transfer","id":"` + txid + `_","amt":"1"}`, ErrInvalidId},
+		"id non-hex":       {`{"p":"bsv-20","op":"transfer","id":"` + strings.Repeat("z", 64) + `_0","amt":"1"}`, ErrInvalidId},
+		"empty symbol":     {`{"p":"bsv-20","op":"deploy+mint","sym":"","amt":"1"}`, ErrInvalidSymbol},
+		"long symbol":      {`{"p":"bsv-20","op":"deploy+mint","sym":"` + strings.Repeat("A", MaxSymbolLength+1) + `","amt":"1"}`, ErrInvalidSymbol},
+		"icon url":         {`{"p":"bsv-20","op":"deploy+mint","amt":"1","icon":"https://example.com/icon.png"}`, ErrInvalidIcon},
+		"icon vout signed": {`{"p":"bsv-20","op":"deploy+mint","amt":"1","icon":"_-1"}`, ErrInvalidIcon},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeStrict(inscribe(t, tt.content))
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	_, err = DecodeStrict(script.NewFromBytes([]byte{script.OpTRUE}))
+	require.ErrorIs(t, err, ErrNoInscription)
+
+	s, err := (&inscription.Inscription{File: inscription.File{Type: "text/plain", Content: []byte("{}")}}).Lock()
+	require.NoError(t, err)
+	_, err = DecodeStrict(s)
+	require.ErrorIs(t, err, ErrInvalidContentType)
+
+	// Decode stays lenient about fields only DecodeStrict validates
+	require.NotNil(t, Decode(inscribe(t, `{"p":"bsv-20","op":"transfer","id":"abc_0","amt":"1"}`)))
+}
+
+// TestLockCanonical tests that Lock emits the protocol JSON and decodes back
+func TestLockCanonical(t *testing.T) {
+	const id = "dfa24771dbd093efbddf19ec424eab60113e288672c23182be75ec3f5452ba8d_0"
+	suffix, err := script.NewFromHex("76a914000000000000000000000000000000000000000088ac")
+	require.NoError(t, err)
+	sym, icon := "BUIDL", "_1"
+	dec := uint8(2)
+	amt, ok := new(big.Int).SetString("42000000000000000000000", 10)
+	require.True(t, ok)
+
+	tests := []struct {
+		token *Bsv21
+		json  string
+	}{
+		{
+			&Bsv21{Op: string(OpMint), Symbol: &sym, Decimals: &dec, Icon: &icon, Amt: amt, Id: "ignored"},
+			`{"p":"bsv-20","op":"deploy+mint","sym":"BUIDL","amt":"42000000000000000000000","dec":"2","icon":"_1"}`,
+		},
+		{
+			&Bsv21{Op: string(OpMint), Amt: big.NewInt(1)},
+			`{"p":"bsv-20","op":"deploy+mint","amt":"1"}`,
+		},
+		{
+			&Bsv21{Op: string(OpTransfer), Id: id, Amt: big.NewInt(100), Symbol: &sym},
+			`{"p":"bsv-20","op":"transfer","id":"` + id + `","amt":"100"}`,
+		},
+		{
+			&Bsv21{Op: string(OpBurn), Id: id, Amt: big.NewInt(5)},
+			`{"p":"bsv-20","op":"burn","id":"` + id + `","amt":"5"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token.Op, func(t *testing.T) {
+			s, err := tt.token.Lock(suffix)
+			require.NoError(t, err)
+
+			decoded, err := DecodeStrict(s)
+			require.NoError(t, err)
+			require.Equal(t, tt.json, string(decoded.Insc.File.Content))
+			require.Equal(t, []byte(*suffix), decoded.Insc.ScriptSuffix)
+			require.Equal(t, 0, tt.token.Amt.Cmp(decoded.Amt))
+		})
+	}
+
+	_, err = (&Bsv21{Op: "deploy", Amt: big.NewInt(1)}).Lock(suffix)
+	require.ErrorIs(t, err, ErrInvalidOp)
+	_, err = (&Bsv21{Op: string(OpTransfer), Amt: big.NewInt(1)}).Lock(suffix)
+	require.ErrorIs(t, err, ErrMissingId)
+}
+
+func tokenUTXO(t *testing.T, key *ec.PrivateKey, token *Bsv21, txid string, vout uint32) *transaction.UTXO {
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+	lockingScript, err := p2pkh.Lock(address)
+	require.NoError(t, err)
+	lockingScript, err = token.Lock(lockingScript)
+	require.NoError(t, err)
+	unlock, err := p2pkh.Unlock(key, nil)
+	require.NoError(t, err)
+	hash, err := chainhash.NewHashFromHex(txid)
+	require.NoError(t, err)
+	return &transaction.UTXO{
+		TxID:                    hash,
+		Vout:                    vout,
+		LockingScript:           lockingScript,
+		Satoshis:                1,
+		UnlockingScriptTemplate: unlock,
+	}
+}
+
+func tokenAmounts(t *testing.T, tx *transaction.Transaction) []string {
+	amounts := []string{}
+	for _, output := range tx.Outputs {
+		if token := Decode(output.LockingScript); token != nil {
+			amounts = append(amounts, token.Op+":"+token.Id+":"+token.Amt.String())
+		}
+	}
+	return amounts
+}
+
+// TestBuildTransferTx tests that token amounts balance across the transaction
+func TestBuildTransferTx(t *testing.T) {
+	const deployTxid = "dfa24771dbd093efbddf19ec424eab60113e288672c23182be75ec3f5452ba8d"
+	const id = deployTxid + "_0"
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+	sym := "BUIDL"
+
+	deploy := tokenUTXO(t, key, &Bsv21{Op: string(OpMint), Symbol: &sym, Amt: big.NewInt(1000)}, deployTxid, 0)
+	transfer := tokenUTXO(t, key, &Bsv21{Op: string(OpTransfer), Id: id, Amt: big.NewInt(500)}, strings.Repeat("11", 32), 1)
+	payment := &transaction.UTXO{
+		TxID:                    deploy.TxID,
+		Vout:                    2,
+		LockingScript:           p2pkhScript(t, address),
+		Satoshis:                10000,
+		UnlockingScriptTemplate: deploy.UnlockingScriptTemplate,
+	}
+
+	tx, err := BuildTransferTx(
+		[]*transaction.UTXO{deploy, transfer},
+		[]*TokenRecipient{{address, big.NewInt(600)}, {address, big.NewInt(300)}},
+		address,
+		big.NewInt(100),
+		[]*transaction.UTXO{payment},
+		address,
+	)
+	require.NoError(t, err)
+	require.Len(t, tx.Inputs, 3)
+	require.Len(t, tx.Outputs, 5)
+	require.Equal(t, []string{
+		"transfer:" + id + ":600",
+		"transfer:" + id + ":300",
+		"burn:" + id + ":100",
+		"transfer:" + id + ":500",
+	}, tokenAmounts(t, tx))
+	require.True(t, tx.Outputs[4].Change)
+	require.NoError(t, tx.Fee(&feemodel.SatoshisPerKilobyte{Satoshis: 1}, transaction.ChangeDistributionEqual))
+	require.NoError(t, tx.Sign())
+
+	// Exact spends need no token change address
+	tx, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{{address, big.NewInt(500)}}, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"transfer:" + id + ":500"}, tokenAmounts(t, tx))
+
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{{address, big.NewInt(501)}}, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrInsufficientTokens)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{{address, big.NewInt(400)}}, address, big.NewInt(101), nil, nil)
+	require.ErrorIs(t, err, ErrInsufficientTokens)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{{address, big.NewInt(400)}}, nil, nil, nil, nil)
+	require.ErrorIs(t, err, ErrNoTokenChangeAddress)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{{address, big.NewInt(0)}}, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidAmount)
+	_, err = BuildTransferTx(nil, nil, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrNoTokenInputs)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer, payment}, nil, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrNotToken)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer, nil}, nil, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrNotToken)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{nil}, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidRecipient)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer}, []*TokenRecipient{{nil, big.NewInt(1)}}, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidRecipient)
+
+	other := tokenUTXO(t, key, &Bsv21{Op: string(OpTransfer), Id: strings.Repeat("22", 32) + "_0", Amt: big.NewInt(1)}, strings.Repeat("33", 32), 0)
+	_, err = BuildTransferTx([]*transaction.UTXO{transfer, other}, nil, address, nil, nil, nil)
+	require.ErrorIs(t, err, ErrMixedTokens)
+}
+
+func p2pkhScript(t *testing.T, address *script.Address) *script.Script {
+	s, err := p2pkh.Lock(address)
+	require.NoError(t, err)
+	return s
+}
+
+// TestNewDeployMint tests deploying a token and deriving its id
+func TestNewDeployMint(t *testing.T) {
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+
+	icon, err := (&inscription.Inscription{File: inscription.File{Type: "image/png", Content: []byte{0x89, 'P', 'N', 'G'}}}).Lock()
+	require.NoError(t, err)
+	deploy, err := NewDeployMint("BUIDL", 2, big.NewInt(4200000000), "_0", p2pkhScript(t, address))
+	require.NoError(t, err)
+
+	decoded, err := DecodeStrict(deploy.LockingScript)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"p":"bsv-20","op":"deploy+mint","sym":"BUIDL","amt":"4200000000","dec":"2","icon":"_0"}`, string(decoded.Insc.File.Content))
+	require.Equal(t, []byte(*p2pkhScript(t, address)), decoded.Insc.ScriptSuffix)
+
+	unlock, err := p2pkh.Unlock(key, nil)
+	require.NoError(t, err)
+	tx := transaction.NewTransaction()
+	require.NoError(t, tx.AddInputsFromUTXOs(&transaction.UTXO{
+		TxID:                    &chainhash.Hash{1},
+		LockingScript:           p2pkhScript(t, address),
+		Satoshis:                1000,
+		UnlockingScriptTemplate: unlock,
+	}))
+	tx.AddOutput(&transaction.TransactionOutput{LockingScript: icon, Satoshis: 1})
+	tx.AddOutput(&transaction.TransactionOutput{LockingScript: deploy.LockingScript, Satoshis: 1})
+	require.NoError(t, tx.Sign())
+
+	id, err := deploy.TokenId(tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.TxID().String()+"_1", id)
+
+	_, err = deploy.TokenId(transaction.NewTransaction())
+	require.ErrorIs(t, err, ErrDeployNotFound)
+
+	_, err = NewDeployMint("BUIDL", 2, big.NewInt(0), "", nil)
+	require.ErrorIs(t, err, ErrInvalidAmount)
+	_, err = NewDeployMint("", 2, big.NewInt(1), "", nil)
+	require.ErrorIs(t, err, ErrInvalidSymbol)
+	_, err = NewDeployMint("BUIDL", 19, big.NewInt(1), "", nil)
+	require.ErrorIs(t, err, ErrInvalidDecimals)
+	_, err = NewDeployMint("BUIDL", 2, big.NewInt(1), "icon.png", nil)
+	require.ErrorIs(t, err, ErrInvalidIcon)
+}
@@ -10,13 +10,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
-	"strconv"
+	"strings"
 
 	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
 	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
 
+	"github.com/bsv-blockchain/go-script-templates/lib"
 	"github.com/bsv-blockchain/go-script-templates/template/bsv21"
 	"github.com/bsv-blockchain/go-script-templates/template/cosign"
 	"github.com/bsv-blockchain/go-script-templates/template/inscription"
@@ -31,116 +32,17 @@ type OrdCosign struct {
 	Cosign *cosign.Cosign `json:"cosign"` // The cosign data (owner and approver)
 }
 
-// Decode attempts to extract an OrdCosign from a script
-func Decode(s *script.Script) *OrdCosign {
+// Decode attempts to extract an OrdCosign from a script. Addresses are encoded
+// for the given network, defaulting to mainnet.
+func Decode(s *script.Script, network ...lib.Network) *OrdCosign {
 	if s == nil {
 		return nil
 	}
 
-	// Try to decode the inscription directly to see what it contains
-	insc := inscription.Decode(s)
-	if insc != nil {
-		// We have an inscription, let's manually check for BSV21 token format
-		if insc.File.Type == "application/bsv-20" {
-			var data map[string]interface{}
-			if err := json.Unmarshal(insc.File.Content, &data); err == nil {
-				// Check if this is a BSV21 token (has p=bsv-20)
-				if p, ok := data["p"]; ok && p == "bsv-20" {
-					// This looks like a BSV21 token, create one manually
-					token := &bsv21.Bsv21{
-						Insc: insc,
-					}
-
-					// Add required fields
-					if op, ok := data["op"].(string); ok {
-						token.Op = op
-					}
-
-					if amt, ok := data["amt"].(float64); ok {
-						token.Amt = uint64(amt)
-					} else if amtStr, ok := data["amt"].(string); ok {
-						if amtVal, err := strconv.ParseUint(amtStr, 10, 64); err == nil {
-							token.Amt = amtVal
-						}
-					}
-
-					// Add optional fields
-					if sym, ok := data["sym"].(string); ok {
-						token.Symbol = &sym
-					}
-
-					if dec, ok := data["dec"].(float64); ok {
-						decValue := uint8(dec)
-						token.Decimals = &decValue
-					} else if decStr, ok := data["dec"].(string); ok {
-						if decVal, err := strconv.ParseUint(decStr, 10, 8); err == nil {
-							decValue := uint8(decVal)
-							token.Decimals = &decValue
-						}
-					}
-
-					if id, ok := data["id"].(string); ok {
-						token.Id = id
-					}
-
-					// Try to extract cosign data
-					var cosignData *cosign.Cosign
-
-					// Check for cosign in script suffix
-					if len(insc.ScriptSuffix) > 0 {
-						suffix := script.NewFromBytes(insc.ScriptSuffix)
-						cosignData = cosign.Decode(suffix)
-					}
-
-					// If no cosign data found, try the full script
-					if cosignData == nil {
-						cosignData = cosign.Decode(s)
-					}
-
-					// If still no cosign data, look for a P2PKH-like script
-					if cosignData == nil {
-						chunks, err := s.Chunks()
-						if err == nil {
-							// Look for DUP HASH160 pattern that starts P2PKH scripts
-							for i := 0; i < len(chunks); i++ {
-								if i+4 < len(chunks) &&
-									chunks[i].Op == script.OpDUP &&
-									chunks[i+1].Op == script.OpHASH160 &&
-									len(chunks[i+2].Data) == 20 &&
-									chunks[i+3].Op == script.OpEQUALVERIFY &&
-									chunks[i+4].Op == script.OpCHECKSIG {
-
-									// Extract the address
-									addr, err := script.NewAddressFromPublicKeyHash(chunks[i+2].Data, true)
-									if err == nil {
-										// Create a minimal Cosign with just the address
-										cosignData = &cosign.Cosign{
-											Address: addr.AddressString,
-										}
-										break
-									}
-								}
-							}
-						}
-					}
-
-					// If we still don't have cosign data, this isn't a valid OrdCosign
-					if cosignData == nil {
-						return nil
-					}
-
-					// Create and return the OrdCosign
-					return &OrdCosign{
-						Token:  token,
-						Cosign: cosignData,
-					}
-				}
-			}
-		}
-	}
-
-	// Fall back to standard BSV21 decode
 	token := bsv21.Decode(s)
+	if token == nil {
+		token = decodeLegacyToken(s)
+	}
 	if token == nil {
 		return nil
 	}
@@ -151,12 +53,12 @@ func Decode(s *script.Script) *OrdCosign {
 	// First check if the token has an inscription with a suffix
 	if token.Insc != nil && len(token.Insc.ScriptSuffix) > 0 {
 		suffix := script.NewFromBytes(token.Insc.ScriptSuffix)
-		cosignData = cosign.Decode(suffix)
+		cosignData = cosign.Decode(suffix, network...)
 	}
 
 	// If no cosign data found in suffix, try the full script
 	if cosignData == nil {
-		cosignData = cosign.Decode(s)
+		cosignData = cosign.Decode(s, network...)
 	}
 
 	// If still no cosign data, look for a P2PKH-like script
@@ -173,7 +75,7 @@ func Decode(s *script.Script) *OrdCosign {
 					chunks[i+4].Op == script.OpCHECKSIG {
 
 					// Extract the address
-					addr, err := script.NewAddressFromPublicKeyHash(chunks[i+2].Data, true)
+					addr, err := script.NewAddressFromPublicKeyHash(chunks[i+2].Data, lib.IsMainnet(network...))
 					if err == nil {
 						// Create a minimal Cosign with just the address
 						cosignData = &cosign.Cosign{
@@ -198,6 +100,40 @@ func Decode(s *script.Script) *OrdCosign {
 	}
 }
 
+// decodeLegacyToken decodes the token JSON written by earlier versions of
+// Lock, which encoded amt and dec as JSON numbers rather than strings.
+func decodeLegacyToken(s *script.Script) *bsv21.Bsv21 {
+	insc := inscription.Decode(s)
+	if insc == nil || insc.File.Type != "application/bsv-20" {
+		return nil
+	}
+	var data struct {
+		P string `json:"p"`
+	}
+	if err := json.Unmarshal(insc.File.Content, &data); err != nil || data.P != "bsv-20" {
+		return nil
+	}
+	token := &bsv21.Bsv21{}
+	if err := json.Unmarshal(insc.File.Content, token); err != nil {
+		return nil
+	}
+	token.Op = strings.ToLower(token.Op)
+	switch token.Op {
+	case string(bsv21.OpMint):
+	case string(bsv21.OpTransfer), string(bsv21.OpBurn):
+		if token.Id == "" {
+			return nil
+		}
+	default:
+		return nil
+	}
+	if token.Decimals != nil && *token.Decimals > bsv21.MaxDecimals {
+		return nil
+	}
+	token.Insc = insc
+	return token
+}
+
 // Lock creates a combined script that includes a BSV21 token with a Cosign locking script.
 func (oc *OrdCosign) Lock(approverPubKey *ec.PublicKey) (*script.Script, error) {
 	// Check if we have a Token and a Cosign
@@ -217,43 +153,9 @@ func (oc *OrdCosign) Lock(approverPubKey *ec.PublicKey) (*script.Script, error)
 		return nil, err
 	}
 
-	// Create a BSV21 format token with the "p":"bsv-20" field included
-	tokenData := map[string]interface{}{
-		"p":   "bsv-20",
-		"op":  oc.Token.Op,
-		"amt": oc.Token.Amt,
-	}
-
-	// Add optional fields if they exist
-	if oc.Token.Symbol != nil {
-		tokenData["sym"] = *oc.Token.Symbol
-	}
-	if oc.Token.Decimals != nil {
-		tokenData["dec"] = *oc.Token.Decimals
-	}
-	if oc.Token.Icon != nil {
-		tokenData["icon"] = *oc.Token.Icon
-	}
-	if oc.Token.Id != "" {
-		tokenData["id"] = oc.Token.Id
-	}
-
-	// Marshal to JSON
-	tokenJSON, err := json.Marshal(tokenData)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create an inscription with BSV21 token data
-	insc := &inscription.Inscription{
-		File: inscription.File{
-			Content: tokenJSON,
-			Type:    "application/bsv-20",
-		},
-		ScriptSuffix: *cosignScript,
-	}
-
-	return insc.Lock()
+	// Inscribe the token in front of the cosign script using the canonical
+	// BSV-21 JSON, with amounts and decimals written as strings
+	return oc.Token.Lock(cosignScript)
 }
 
 // Create a new OrdCosign with the given address, approver, and token
@@ -3,6 +3,7 @@ package bsv21cosign
 import (
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
 	"os"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bsv-blockchain/go-script-templates/template/bsv21"
+	"github.com/bsv-blockchain/go-script-templates/template/cosign"
 	"github.com/bsv-blockchain/go-script-templates/template/inscription"
 	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
 )
@@ -37,7 +39,7 @@ func TestOrdCosignCreateAndDecode(t *testing.T) {
 	decimals := uint8(2)
 	bsv21Token := &bsv21.Bsv21{
 		Op:       "deploy+mint",
-		Amt:      1000000,
+		Amt:      big.NewInt(1000000),
 		Symbol:   &symbol,
 		Decimals: &decimals,
 	}
@@ -138,7 +140,7 @@ func TestOrdCosignCreateAndDecode(t *testing.T) {
 	// Verify the decoded data
 	require.NotNil(t, decodedOrdCosign.Token, "Decoded token should not be nil")
 	require.Equal(t, "deploy+mint", decodedOrdCosign.Token.Op, "Operation should match")
-	require.Equal(t, uint64(1000000), decodedOrdCosign.Token.Amt, "Amount should match")
+	require.Equal(t, uint64(1000000), decodedOrdCosign.Token.Amt.Uint64(), "Amount should match")
 	require.NotNil(t, decodedOrdCosign.Token.Symbol, "Symbol should not be nil")
 	require.Equal(t, "TEST", *decodedOrdCosign.Token.Symbol, "Symbol should match")
 	require.NotNil(t, decodedOrdCosign.Token.Decimals, "Decimals should not be nil")
@@ -225,7 +227,7 @@ func TestOrdCosignFromExistingInscription(t *testing.T) {
 	bsv21Token := &bsv21.Bsv21{
 		Insc: insc,
 		Op:   "deploy+mint",
-		Amt:  1000000,
+		Amt:  big.NewInt(1000000),
 	}
 
 	symbol := "TEST"
@@ -251,6 +253,83 @@ func TestOrdCosignFromExistingInscription(t *testing.T) {
 	require.Equal(t, "application/bsv-20", decodedOrdCosign.Token.Insc.File.Type, "File type should match")
 }
 
+// TestOrdCosignLargeAmount tests that amounts beyond float64 precision
+// survive a round trip and are written as canonical strings
+func TestOrdCosignLargeAmount(t *testing.T) {
+	ownerPrivateKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	approverPrivateKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	ownerAddress, err := script.NewAddressFromPublicKey(ownerPrivateKey.PubKey(), true)
+	require.NoError(t, err)
+
+	amt, ok := new(big.Int).SetString("123456789012345678901", 10)
+	require.True(t, ok)
+	decimals := uint8(8)
+	ordCosign, err := Create(ownerAddress, approverPrivateKey.PubKey(), &bsv21.Bsv21{
+		Op:       string(bsv21.OpMint),
+		Amt:      amt,
+		Decimals: &decimals,
+	})
+	require.NoError(t, err)
+
+	lockingScript, err := ordCosign.Lock(approverPrivateKey.PubKey())
+	require.NoError(t, err)
+
+	decoded := Decode(lockingScript)
+	require.NotNil(t, decoded)
+	require.Equal(t, 0, amt.Cmp(decoded.Token.Amt), "Amount should match exactly")
+	require.Equal(t, decimals, *decoded.Token.Decimals)
+	require.Equal(t, ownerAddress.AddressString, decoded.Cosign.Address)
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal(decoded.Token.Insc.File.Content, &data))
+	require.Equal(t, amt.String(), data["amt"])
+	require.Equal(t, "8", data["dec"])
+
+	token := bsv21.Decode(lockingScript)
+	require.NotNil(t, token)
+	require.Equal(t, 0, amt.Cmp(token.Amt))
+}
+
+// TestOrdCosignLegacyNumericJSON tests that outputs written by earlier
+// versions of Lock, with amt and dec as JSON numbers, still decode
+func TestOrdCosignLegacyNumericJSON(t *testing.T) {
+	ownerPrivateKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	approverPrivateKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	ownerAddress, err := script.NewAddressFromPublicKey(ownerPrivateKey.PubKey(), true)
+	require.NoError(t, err)
+
+	cosignScript, err := cosign.Lock(ownerAddress, approverPrivateKey.PubKey())
+	require.NoError(t, err)
+	insc := &inscription.Inscription{
+		File: inscription.File{
+			Content: []byte(`{"amt":1000000,"dec":2,"op":"deploy+mint","p":"bsv-20","sym":"TEST"}`),
+			Type:    "application/bsv-20",
+		},
+		ScriptSuffix: *cosignScript,
+	}
+	lockingScript, err := insc.Lock()
+	require.NoError(t, err)
+
+	require.Nil(t, bsv21.Decode(lockingScript), "bsv21 only decodes canonical string values")
+	decoded := Decode(lockingScript)
+	require.NotNil(t, decoded)
+	require.Equal(t, string(bsv21.OpMint), decoded.Token.Op)
+	require.Equal(t, int64(1000000), decoded.Token.Amt.Int64())
+	require.Equal(t, uint8(2), *decoded.Token.Decimals)
+	require.Equal(t, "TEST", *decoded.Token.Symbol)
+	require.Equal(t, ownerAddress.AddressString, decoded.Cosign.Address)
+
+	// A legacy transfer must still name its token
+	insc.File.Content = []byte(`{"amt":5,"op":"transfer","p":"bsv-20"}`)
+	lockingScript, err = insc.Lock()
+	require.NoError(t, err)
+	require.Nil(t, Decode(lockingScript))
+}
+
 // TestDecodeMNEEToken tests decoding the MNEE token which is a BSV21 token with cosign
 func TestDecodeMNEEToken(t *testing.T) {
 	// Load the test vector hex data for MNEE token transfer with cosign
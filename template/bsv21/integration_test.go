@@ -47,7 +47,7 @@ func TestDecodePOW20Integration(t *testing.T) {
 	// Verify BSV21 data
 	t.Logf("BSV21 data: Op=%s, Amt=%d", bsv21Data.Op, bsv21Data.Amt)
 	require.Equal(t, "deploy+mint", bsv21Data.Op, "Operation should be deploy+mint")
-	require.Equal(t, uint64(4200000000), bsv21Data.Amt, "Amount should be 4200000000")
+	require.Equal(t, uint64(4200000000), bsv21Data.Amt.Uint64(), "Amount should be 4200000000")
 
 	// Check the Symbol (sym)
 	require.NotNil(t, bsv21Data.Symbol, "Symbol should not be nil")
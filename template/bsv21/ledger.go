@@ -0,0 +1,123 @@
+package bsv21
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bsv-blockchain/go-sdk/transaction"
+)
+
+var (
+	ErrMissingSource = errors.New("input source output not supplied")
+	ErrUnfundedToken = errors.New("token not spent by any input")
+)
+
+// TokenLedger is the movement of one token through a transaction
+type TokenLedger struct {
+	Id        string
+	Inputs    *big.Int // Tokens spent by the transaction's inputs
+	Minted    *big.Int // Tokens created by a deploy+mint output
+	Transfers *big.Int // Tokens assigned to transfer outputs
+	Burns     *big.Int // Tokens destroyed by burn outputs
+	Err       error    // Why the token's outputs are invalid, nil if they are valid
+}
+
+// Valid reports whether the token's outputs are covered by its inputs
+func (l *TokenLedger) Valid() bool {
+	return l.Err == nil
+}
+
+// Unallocated returns the input tokens not assigned to any output, which are
+// lost once the transaction is mined
+func (l *TokenLedger) Unallocated() *big.Int {
+	unallocated := new(big.Int).Sub(l.Inputs, l.Transfers)
+	unallocated.Sub(unallocated, l.Burns)
+	if unallocated.Sign() < 0 {
+		return new(big.Int)
+	}
+	return unallocated
+}
+
+// ValidateTx decodes the tokens spent and created by tx and checks, per token
+// id, that transfers and burns do not exceed the tokens spent. Inputs and
+// outputs are decoded with DecodeStrict, and those that fail validation hold
+// no tokens and are skipped. Tokens paid out without any input holding them
+// are flagged with ErrMixedTokens when the inputs hold other tokens, and with
+// ErrUnfundedToken when they hold none. Inputs must have their source outputs
+// attached. When one is missing, tokens that do not balance are flagged with
+// ErrMissingSource since the input may have held them.
+func ValidateTx(tx *transaction.Transaction) map[string]*TokenLedger {
+	ledgers := map[string]*TokenLedger{}
+	ledger := func(id string) *TokenLedger {
+		if l, ok := ledgers[id]; ok {
+			return l
+		}
+		l := &TokenLedger{
+			Id:        id,
+			Inputs:    new(big.Int),
+			Minted:    new(big.Int),
+			Transfers: new(big.Int),
+			Burns:     new(big.Int),
+		}
+		ledgers[id] = l
+		return l
+	}
+
+	var missingSource bool
+	for _, input := range tx.Inputs {
+		source := input.SourceTxOutput()
+		if source == nil || input.SourceTXID == nil {
+			missingSource = true
+			continue
+		}
+		token, err := DecodeStrict(source.LockingScript)
+		if err != nil {
+			continue
+		}
+		switch token.Op {
+		case string(OpMint):
+			id := (&transaction.Outpoint{Txid: *input.SourceTXID, Index: input.SourceTxOutIndex}).OrdinalString()
+			ledger(id).Inputs.Add(ledger(id).Inputs, token.Amt)
+		case string(OpTransfer):
+			ledger(token.Id).Inputs.Add(ledger(token.Id).Inputs, token.Amt)
+		}
+	}
+
+	tokenInputs := len(ledgers) > 0
+	for vout, output := range tx.Outputs {
+		token, err := DecodeStrict(output.LockingScript)
+		if err != nil {
+			continue
+		}
+		switch token.Op {
+		case string(OpMint):
+			id := (&transaction.Outpoint{
+				Txid:  *tx.TxID(),
+				Index: uint32(vout), //nolint:gosec // G115: output counts fit in uint32
+			}).OrdinalString()
+			ledger(id).Minted.Add(ledger(id).Minted, token.Amt)
+		case string(OpTransfer):
+			ledger(token.Id).Transfers.Add(ledger(token.Id).Transfers, token.Amt)
+		case string(OpBurn):
+			ledger(token.Id).Burns.Add(ledger(token.Id).Burns, token.Amt)
+		}
+	}
+
+	for _, l := range ledgers {
+		spent := new(big.Int).Add(l.Transfers, l.Burns)
+		if spent.Cmp(l.Inputs) <= 0 {
+			continue
+		}
+		switch {
+		case missingSource:
+			l.Err = ErrMissingSource
+		case l.Inputs.Sign() == 0 && tokenInputs:
+			l.Err = ErrMixedTokens
+		case l.Inputs.Sign() == 0:
+			l.Err = ErrUnfundedToken
+		default:
+			l.Err = ErrInsufficientTokens
+		}
+	}
+	return ledgers
+}
@@ -0,0 +1,87 @@
+package bsv21
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+var ledgerScript = script.NewFromBytes([]byte{script.OpTRUE})
+
+func addTokenInput(t *testing.T, tx *transaction.Transaction, token *Bsv21, txid string, vout uint32) {
+	t.Helper()
+	lockingScript, err := token.Lock(ledgerScript)
+	require.NoError(t, err)
+	hash, err := chainhash.NewHashFromHex(txid)
+	require.NoError(t, err)
+	require.NoError(t, tx.AddInputsFromUTXOs(&transaction.UTXO{
+		TxID:          hash,
+		Vout:          vout,
+		LockingScript: lockingScript,
+		Satoshis:      1,
+	}))
+}
+
+func addTokenOutput(t *testing.T, tx *transaction.Transaction, token *Bsv21) {
+	t.Helper()
+	lockingScript, err := token.Lock(ledgerScript)
+	require.NoError(t, err)
+	tx.AddOutput(&transaction.TransactionOutput{LockingScript: lockingScript, Satoshis: 1})
+}
+
+// TestValidateTx tests per-token balance checks across inputs and outputs
+func TestValidateTx(t *testing.T) {
+	deployTxid := strings.Repeat("aa", 32)
+	idA := deployTxid + "_0"
+	idB := strings.Repeat("bb", 32) + "_0"
+	idC := strings.Repeat("cc", 32) + "_0"
+
+	tx := transaction.NewTransaction()
+	addTokenInput(t, tx, &Bsv21{Op: string(OpMint), Amt: big.NewInt(1000)}, deployTxid, 0)
+	addTokenInput(t, tx, &Bsv21{Op: string(OpTransfer), Id: idB, Amt: big.NewInt(50)}, strings.Repeat("11", 32), 0)
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpTransfer), Id: idA, Amt: big.NewInt(700)})
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpBurn), Id: idA, Amt: big.NewInt(200)})
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpTransfer), Id: idB, Amt: big.NewInt(51)})
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpTransfer), Id: idC, Amt: big.NewInt(1)})
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpMint), Amt: big.NewInt(5)})
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpTransfer), Id: "abc_0", Amt: big.NewInt(1)})
+	tx.AddOutput(&transaction.TransactionOutput{LockingScript: ledgerScript, Satoshis: 1})
+
+	ledgers := ValidateTx(tx)
+	require.Len(t, ledgers, 4)
+
+	a := ledgers[idA]
+	require.True(t, a.Valid())
+	require.Equal(t, int64(1000), a.Inputs.Int64())
+	require.Equal(t, int64(700), a.Transfers.Int64())
+	require.Equal(t, int64(200), a.Burns.Int64())
+	require.Equal(t, int64(100), a.Unallocated().Int64())
+
+	require.ErrorIs(t, ledgers[idB].Err, ErrInsufficientTokens)
+	require.Zero(t, ledgers[idB].Unallocated().Sign())
+	require.ErrorIs(t, ledgers[idC].Err, ErrMixedTokens)
+	require.NotContains(t, ledgers, "abc_0", "outputs failing DecodeStrict hold no tokens")
+
+	minted := ledgers[tx.TxID().String()+"_4"]
+	require.NotNil(t, minted)
+	require.True(t, minted.Valid())
+	require.Equal(t, int64(5), minted.Minted.Int64())
+
+	// Without every source output, unbalanced tokens cannot be judged
+	tx.AddInput(&transaction.TransactionInput{SourceTXID: &chainhash.Hash{1}})
+	ledgers = ValidateTx(tx)
+	require.True(t, ledgers[idA].Valid())
+	require.ErrorIs(t, ledgers[idB].Err, ErrMissingSource)
+	require.ErrorIs(t, ledgers[idC].Err, ErrMissingSource)
+
+	// Paying out tokens without spending any
+	tx = transaction.NewTransaction()
+	addTokenOutput(t, tx, &Bsv21{Op: string(OpTransfer), Id: idC, Amt: big.NewInt(1)})
+	ledgers = ValidateTx(tx)
+	require.ErrorIs(t, ledgers[idC].Err, ErrUnfundedToken)
+}
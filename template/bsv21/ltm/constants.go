@@ -3,6 +3,6 @@ package ltm
 import "github.com/bsv-blockchain/go-sdk/script"
 
 var (
-	ltmPrefix, _ = script.NewFromHex("615479011c7a75011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a5379011b7a75011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a00011d7a75011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a5579011a7a7501197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a011b7909ffffffffffffffff00a169011a790112a1690079040065cd1d9f69547901197a7501187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a517901187a7501177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a527901177a7501167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a007901167a7501157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a7575757575756101437961007901687f776100005279517f75007f77007901fd87635379537f75517f7761007901007e81517a7561537a75527a527a5379535479937f75537f77527a75517a67007901fe87635379557f75517f7761007901007e81517a7561537a75527a527a5379555479937f75557f77527a75517a67007901ff87635379597f75517f7761007901007e81517a7561537a75527a527a5379595479937f75597f77527a75517a675379517f75007f7761007901007e81517a7561537a75527a527a5379515479937f75517f77527a75517a6868685179517a75517a75517a75517a7561517a7561007961007982775179517951947f755179549451947f77007981527951799454945194517a75517a75517a75517a7561517951797f75537a75527a527a0000537953797f77610079537a75527a527a00527a75517a7561615179517951937f7551797f775179768b537a75527a527a75010051798791517a75610079916361005379005179557951937f7555797f77815579768b577a75567a567a567a567a567a567a750079014c9f630079547a75537a537a537a527956795579937f7556797f77527a75517a670079014c9c635279567951937f7556797f7761007901007e81517a7561547a75537a537a537a55795193567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014d9c635279567952937f7556797f7761007901007e81517a7561547a75537a537a537a55795293567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014e9c635279567954937f7556797f7761007901007e81517a7561547a75537a537a537a55795493567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670069686868685579547993567a75557a557a557a557a557a5579755179517a75517a75517a75517a7561011c7a75011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a6161005379005179557951937f7555797f77815579768b577a75567a567a567a567a567a567a750079014c9f630079547a75537a537a537a527956795579937f7556797f77527a75517a670079014c9c635279567951937f7556797f7761007901007e81517a7561547a75537a537a537a55795193567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014d9c635279567952937f7556797f7761007901007e81517a7561547a75537a537a537a55795293567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014e9c635279567954937f7556797f7761007901007e81517a7561547a75537a537a537a55795493567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670069686868685579547993567a75557a557a557a557a557a5579755179517a75517a75517a75517a7561816101187a7501177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a6161005379005179557951937f7555797f77815579768b577a75567a567a567a567a567a567a750079014c9f630079547a75537a537a537a527956795579937f7556797f77527a75517a670079014c9c635279567951937f7556797f7761007901007e81517a7561547a75537a537a537a55795193567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014d9c635279567952937f7556797f7761007901007e81517a7561547a75537a537a537a55795293567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014e9c635279567954937f7556797f7761007901007e81517a7561547a75537a537a537a55795493567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670069686868685579547993567a75557a557a557a557a557a5579755179517a75517a75517a75517a7561816101157a7501147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a6801487901c1615179013f79013f79210ac407f0e4bd44bfc207355a778b046225a7068fc59ee7eda43ad905aadbffc800206c266b30e6a1319c66dc401e5bd6b432ba49688eecd118297041da8074ce08100141795679615679aa0079610079517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01007e81517a75615779567956795679567961537956795479577995939521414136d08c5ed2bf3ba048afe6dcaebafeffffffffffffffffffffffffffffff00517951796151795179970079009f63007952799367007968517a75517a75517a7561527a75517a517951795296a0630079527994527a75517a6853798277527982775379012080517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01205279947f7754537993527993013051797e527e54797e58797e527e53797e52797e57797e0079517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a756100795779ac517a75517a75517a75517a75517a75517a75517a75517a75517a7561517a75517a756169014879610079610079547f75517a7561517961007901247f75547f77517a7561527961007901447f7501247f77517a7561537961007982775179517958947f7551790128947f77517a75517a7561547961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a756155796100796100798277517951790128947f755179012c947f77517a75517a756161007901007e81517a7561517a7561567961007982775179517953947f75517954947f77517a75517a7561577961007901687f7501447f77517a756101207f75007f77587961007901687f7501447f77517a756101207f7781517951795b7961007901687f776100005279517f75007f77007901fd87635379537f75517f7761007901007e81517a7561537a75527a527a5379535479937f75537f77527a75517a67007901fe87635379557f75517f7761007901007e81517a7561537a75527a527a5379555479937f75557f77527a75517a67007901ff87635379597f75517f7761007901007e81517a7561537a75527a527a5379595479937f75597f77527a75517a675379517f75007f7761007901007e81517a7561537a75527a527a5379515479937f75517f77527a75517a6868685179517a75517a75517a75517a7561517a75615c79610079610079827751795179012c947f7551790134947f77517a75517a756161007901007e81517a7561517a7561537953795379537960796079607960796079607960795a795a795a795a79011c795c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a7561011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a75757575757575757575757501137a01137a01137a01137a01137a01137a01137a01137a014779014779597a597a7575577a577a577a577a577a577a5f79011579a2695f790117799304ffff8f009f695e7905ffffffff009f695f7901157a7501147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a0149796100790117799501197951799f63011979517a75680079517a75517a75610118795179940079011a7a7501197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a00517900a06351796161011f790087616361607961001030313233343536373839616263646566610120009451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120519451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120529451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120539451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120549451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120559451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120569451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120579451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120589451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120599451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205a9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205b9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205c9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205d9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205e9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205f9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120609451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001119451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001129451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001139451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001149451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001159451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001169451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001179451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001189451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001199451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011a9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011b9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011c9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011d9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011e9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011f9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575755179517a75517a75517a7561015f7e6079610079090000000000000000019f69000061007991630061007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635161007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635261007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635361007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635461007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635561007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635661007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635761007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635861007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635961007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635a61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635b61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635c61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635d61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635e61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635f61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991636061007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011161007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011261007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011361007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875685279009c630130527a75517a685179517a75517a75517a75617e01207a75011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a51617568011f795179610079610079090000000000000000019f69000061007991630061007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635161007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635261007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635361007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635461007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635561007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635661007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635761007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635861007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635961007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635a61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635b61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635c61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635d61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635e61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635f61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991636061007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011161007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011261007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011361007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875685279009c630130527a75517a685179517a75517a75517a7561247b2270223a226273762d3230222c226f70223a227472616e73666572222c226964223a2253797e09222c22616d74223a227e51797e02227d7e0079126170706c69636174696f6e2f6273762d323061014e79014d797e036f72646100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e014e797e51796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e014f797e52796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e014c797e517a75517a7561517a75517a75517a75517a75616100610079635167010068517a75610121796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e011d79610079009c630100670079686100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a7561517a75617e011a79610079009c630100670079686100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a7561517a75617e5b79517961007982775480517951797e0051807e517a75517a75617e517a7561610079614f00527982775ba2635279577f7551797f77070063036f726451876700686300795793517a750079755279537982777f7551797f776100005279517f75007f77810079014c9f630079537a75527a527a51537993527a75517a670079014c9c635379527f75517f7761007901007e81517a7561537a75527a527a515193537993527a75517a670079014d9c635379537f75517f7761007901007e81517a7561537a75527a527a515293537993527a75517a670079014e9c635379557f75517f7761007901007e81517a7561537a75527a527a515493537993527a75517a674f527a75517a686868685179517a75517a75517a75517a7561007900a0635179517993527a75517a5179755379527951937f7552797f77015079876351795193527a75517a5179755379547982777f7552797f776100005279517f75007f77810079014c9f630079537a75527a527a51537993527a75517a670079014c9c635379527f75517f7761007901007e81517a7561537a75527a527a515193537993527a75517a670079014d9c635379537f75517f7761007901007e81517a7561537a75527a527a515293537993527a75517a670079014e9c635379557f75517f7761007901007e81517a7561537a75527a527a515493537993527a75517a674f527a75517a686868685179517a75517a75517a75517a7561007900a0635279517993537a75527a527a5279755479537951937f7553797f77014d79876352795193537a75527a527a5279755279547a75537a537a537a686875686875685179517a75517a75517a7561007900a0635179527982777f7551797f77527a75517a685179517a75517a75617e00795161007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561517a75517a7561517a7568011279011a7993014f79014e795279614c662097dfd76851bf465e8f715593b217714858bbe9570ff3bd5e33840a34e20ff0262102ba79df5f8ae7604a9830f03c7933028186aede0675a16f025dc4f8be8eec0382201008ce7480da41702918d1ec8e6849ba32b4d65b1e40dc669c31a1e6306b266c000001147e53797e537e517953807e4d2703610079040065cd1d9f690079547a75537a537a537a5179537a75527a527a7575615579014161517957795779210ac407f0e4bd44bfc207355a778b046225a7068fc59ee7eda43ad905aadbffc800206c266b30e6a1319c66dc401e5bd6b432ba49688eecd118297041da8074ce081059795679615679aa0079610079517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01007e81517a75615779567956795679567961537956795479577995939521414136d08c5ed2bf3ba048afe6dcaebafeffffffffffffffffffffffffffffff00517951796151795179970079009f63007952799367007968517a75517a75517a7561527a75517a517951795296a0630079527994527a75517a6853798277527982775379012080517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01205279947f7754537993527993013051797e527e54797e58797e527e53797e52797e57797e0079517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a756100795779ac517a75517a75517a75517a75517a75517a75517a75517a75517a7561517a75517a756169557961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a7561040065cd1d9f6955796100796100798277517951790128947f755179012c947f77517a75517a756161007901007e81517a7561517a756105ffffffff009f69557961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a75615279a2695679a95179876957795779ac77777777777777777e0079537961007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561517a75517a75517a75517a7561014f7901217956796151795179610079610079090000000000000000019f69000061007991630061007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635161007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635261007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635361007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635461007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635561007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635661007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635761007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635861007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635961007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635a61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635b61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635c61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635d61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635e61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635f61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991636061007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011161007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011261007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011361007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875685279009c630130527a75517a685179517a75517a75517a7561247b2270223a226273762d3230222c226f70223a227472616e73666572222c226964223a2253797e09222c22616d74223a227e51797e02227d7e0079126170706c69636174696f6e2f6273762d3230610152790151797e036f72646100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e0152797e51796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e0153797e52796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e0150797e517a75517a7561517a75517a75517a75517a7561537961014a790149797e01147e51797e014a797e0148797e517a75617e00795161007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561517a75517a75517a75517a7561537952797e51797e615e7900a0635d79610148790147797e01147e51797e0148797e0146797e517a75615f7961007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561670068617e0079aa011879877777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777")
-	ltmSuffix, _ = script.NewFromHex("610079040065cd1d9f690079547a75537a537a537a5179537a75527a527a7575615579014161517957795779210ac407f0e4bd44bfc207355a778b046225a7068fc59ee7eda43ad905aadbffc800206c266b30e6a1319c66dc401e5bd6b432ba49688eecd118297041da8074ce081059795679615679aa0079610079517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01007e81517a75615779567956795679567961537956795479577995939521414136d08c5ed2bf3ba048afe6dcaebafeffffffffffffffffffffffffffffff00517951796151795179970079009f63007952799367007968517a75517a75517a7561527a75517a517951795296a0630079527994527a75517a6853798277527982775379012080517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01205279947f7754537993527993013051797e527e54797e58797e527e53797e52797e57797e0079517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a756100795779ac517a75517a75517a75517a75517a75517a75517a75517a75517a7561517a75517a756169557961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a7561040065cd1d9f6955796100796100798277517951790128947f755179012c947f77517a75517a756161007901007e81517a7561517a756105ffffffff009f69557961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a75615279a2695679a95179876957795779ac7777777777777777")
+	ltmPrefix, _ = script.NewFromHex("010001000151016301680176018801a901ac2097dfd76851bf465e8f715593b217714858bbe9570ff3bd5e33840a34e20ff0262102ba79df5f8ae7604a9830f03c7933028186aede0675a16f025dc4f8be8eec0382201008ce7480da41702918d1ec8e6849ba32b4d65b1e40dc669c31a1e6306b266c515a016402e80302102703a0860103a086015a9503a0860101649503a0860102e8039503a0860102102795510500e40b540209000010632d5ec76b050d00000040eaed7446d09c2c9f0c11000000000061f5b9abbfa45cc3f129631d1500000000000064b5fd3405c4d2876692f9153b6c441500000000000064b5fd3405c4d2876692f9153b6c440500e40b5402951500000000000064b5fd3405c4d2876692f9153b6c4409000010632d5ec76b05951500000000000064b5fd3405c4d2876692f9153b6c440d00000040eaed7446d09c2c9f0c951500000000000064b5fd3405c4d2876692f9153b6c4411000000000061f5b9abbfa45cc3f129631d95512a000000000000000000000000108f2ea80843b2aa7c1a218e40ce8af30bcec484270beb7cc39425ad49124c5400000000000000000000000000000000000000000000000000e1b2b93c75888293163fcd6b3ab489de879e0846454d680ca6dbfd919324df13ec68302744b499ee4181b6c3ca0258f15168d9a225767d8d714e014c7d0000000000000000000000000000000000000000000000000000000000000000000000000010ddf45209455de142b4ae2e34b3a36fa3cd3f6e7a28b4f777c14bd0c8d267e0f8a8ae673bc9adb356c86c0b9d9d9500c1485b3d8abe4af436d9524de8db71c5211cf90981454a6ad8aad77c4ce1089ca59b7500883ce4174ca70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c1a92f7e3724b8f0e932dbeb3346a2d38aa49341aa96498ee059d0e171a25cc7e0e113487cb4cd76e7a0ba5959ce3642fe27a4c58dbf3486afe0fd6ccfecb2a04699ce5398628ed16a0e5c0e1250bfb7d0e53080b3adaf50c18b0b19f58f25de8245e5b68dc377c1fb26cf1ccbf33f97917fecb4014cd00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000106b7babf307a2710dd4f78070b8ba68d1da7243bdee153c28d8ae993881eebd08cb83783fc5cce9532ff94116ff3f27939c17e0881b9acf0b0f99843d70e85c9a04153a49e51d62bf8f0443af9b732b412dd8b1d12c7655df580bd104e61d576545b1b11576e560f94676f47b18464f8152e965bc432b7ea2bd4f2f1e665ebacc52ea78087250b134eebad27093ec5f361f4cd00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000106b7babf307a2710dd4f78070b8ba68d1da7243bdee153c28d8ae993881eebd08cb83783fc5cce9532ff94116ff3f27939c17e0881b9acf0b0f99843d70e85c9a04153a49e51d62bf8f0443af9b732b412dd8b1d12c7655df580bd104e61d576545b1b11576e560f94676f47b18464f8152e965bc432b7ea2bd4f2f1e665ebacc52ea78087250b134eebad27093ec5f361f2a000000000000000000000000108f2ea80843b2aa7c1a218e40ce8af30bcec484270beb7cc39425ad4912954cd00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000106b7babf307a2710dd4f78070b8ba68d1da7243bdee153c28d8ae993881eebd08cb83783fc5cce9532ff94116ff3f27939c17e0881b9acf0b0f99843d70e85c9a04153a49e51d62bf8f0443af9b732b412dd8b1d12c7655df580bd104e61d576545b1b11576e560f94676f47b18464f8152e965bc432b7ea2bd4f2f1e665ebacc52ea78087250b134eebad27093ec5f361f4c5400000000000000000000000000000000000000000000000000e1b2b93c75888293163fcd6b3ab489de879e0846454d680ca6dbfd919324df13ec68302744b499ee4181b6c3ca0258f15168d9a225767d8d714e01954cd00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000106b7babf307a2710dd4f78070b8ba68d1da7243bdee153c28d8ae993881eebd08cb83783fc5cce9532ff94116ff3f27939c17e0881b9acf0b0f99843d70e85c9a04153a49e51d62bf8f0443af9b732b412dd8b1d12c7655df580bd104e61d576545b1b11576e560f94676f47b18464f8152e965bc432b7ea2bd4f2f1e665ebacc52ea78087250b134eebad27093ec5f361f4c7d0000000000000000000000000000000000000000000000000000000000000000000000000010ddf45209455de142b4ae2e34b3a36fa3cd3f6e7a28b4f777c14bd0c8d267e0f8a8ae673bc9adb356c86c0b9d9d9500c1485b3d8abe4af436d9524de8db71c5211cf90981454a6ad8aad77c4ce1089ca59b7500883ce417954cd00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000106b7babf307a2710dd4f78070b8ba68d1da7243bdee153c28d8ae993881eebd08cb83783fc5cce9532ff94116ff3f27939c17e0881b9acf0b0f99843d70e85c9a04153a49e51d62bf8f0443af9b732b412dd8b1d12c7655df580bd104e61d576545b1b11576e560f94676f47b18464f8152e965bc432b7ea2bd4f2f1e665ebacc52ea78087250b134eebad27093ec5f361f4ca70000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c1a92f7e3724b8f0e932dbeb3346a2d38aa49341aa96498ee059d0e171a25cc7e0e113487cb4cd76e7a0ba5959ce3642fe27a4c58dbf3486afe0fd6ccfecb2a04699ce5398628ed16a0e5c0e1250bfb7d0e53080b3adaf50c18b0b19f58f25de8245e5b68dc377c1fb26cf1ccbf33f97917fecb401950000000000000000000000000000000000000000000000")
+	ltmSuffix, _ = script.NewFromHex("615479011c7a75011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a5379011b7a75011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a011a7a00011d7a75011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a011c7a5579011a7a7501197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a011b7909ffffffffffffffff00a169011a790112a1690079040065cd1d9f69547901197a7501187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a01187a517901187a7501177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a527901177a7501167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a01167a007901167a7501157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a01157a7575757575756101437961007901687f776100005279517f75007f77007901fd87635379537f75517f7761007901007e81517a7561537a75527a527a5379535479937f75537f77527a75517a67007901fe87635379557f75517f7761007901007e81517a7561537a75527a527a5379555479937f75557f77527a75517a67007901ff87635379597f75517f7761007901007e81517a7561537a75527a527a5379595479937f75597f77527a75517a675379517f75007f7761007901007e81517a7561537a75527a527a5379515479937f75517f77527a75517a6868685179517a75517a75517a75517a7561517a7561007961007982775179517951947f755179549451947f77007981527951799454945194517a75517a75517a75517a7561517951797f75537a75527a527a0000537953797f77610079537a75527a527a00527a75517a7561615179517951937f7551797f775179768b537a75527a527a75010051798791517a75610079916361005379005179557951937f7555797f77815579768b577a75567a567a567a567a567a567a750079014c9f630079547a75537a537a537a527956795579937f7556797f77527a75517a670079014c9c635279567951937f7556797f7761007901007e81517a7561547a75537a537a537a55795193567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014d9c635279567952937f7556797f7761007901007e81517a7561547a75537a537a537a55795293567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014e9c635279567954937f7556797f7761007901007e81517a7561547a75537a537a537a55795493567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670069686868685579547993567a75557a557a557a557a557a5579755179517a75517a75517a75517a7561011c7a75011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a011b7a6161005379005179557951937f7555797f77815579768b577a75567a567a567a567a567a567a750079014c9f630079547a75537a537a537a527956795579937f7556797f77527a75517a670079014c9c635279567951937f7556797f7761007901007e81517a7561547a75537a537a537a55795193567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014d9c635279567952937f7556797f7761007901007e81517a7561547a75537a537a537a55795293567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014e9c635279567954937f7556797f7761007901007e81517a7561547a75537a537a537a55795493567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670069686868685579547993567a75557a557a557a557a557a5579755179517a75517a75517a75517a7561816101187a7501177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a01177a6161005379005179557951937f7555797f77815579768b577a75567a567a567a567a567a567a750079014c9f630079547a75537a537a537a527956795579937f7556797f77527a75517a670079014c9c635279567951937f7556797f7761007901007e81517a7561547a75537a537a537a55795193567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014d9c635279567952937f7556797f7761007901007e81517a7561547a75537a537a537a55795293567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670079014e9c635279567954937f7556797f7761007901007e81517a7561547a75537a537a537a55795493567a75557a557a557a557a557a557975527956795579937f7556797f77527a75517a670069686868685579547993567a75557a557a557a557a557a5579755179517a75517a75517a75517a7561816101157a7501147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a6801487901c1615179013f79013f79210ac407f0e4bd44bfc207355a778b046225a7068fc59ee7eda43ad905aadbffc800206c266b30e6a1319c66dc401e5bd6b432ba49688eecd118297041da8074ce08100141795679615679aa0079610079517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01007e81517a75615779567956795679567961537956795479577995939521414136d08c5ed2bf3ba048afe6dcaebafeffffffffffffffffffffffffffffff00517951796151795179970079009f63007952799367007968517a75517a75517a7561527a75517a517951795296a0630079527994527a75517a6853798277527982775379012080517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01205279947f7754537993527993013051797e527e54797e58797e527e53797e52797e57797e0079517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a756100795779ac517a75517a75517a75517a75517a75517a75517a75517a75517a7561517a75517a756169014879610079610079547f75517a7561517961007901247f75547f77517a7561527961007901447f7501247f77517a7561537961007982775179517958947f7551790128947f77517a75517a7561547961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a756155796100796100798277517951790128947f755179012c947f77517a75517a756161007901007e81517a7561517a7561567961007982775179517953947f75517954947f77517a75517a7561577961007901687f7501447f77517a756101207f75007f77587961007901687f7501447f77517a756101207f7781517951795b7961007901687f776100005279517f75007f77007901fd87635379537f75517f7761007901007e81517a7561537a75527a527a5379535479937f75537f77527a75517a67007901fe87635379557f75517f7761007901007e81517a7561537a75527a527a5379555479937f75557f77527a75517a67007901ff87635379597f75517f7761007901007e81517a7561537a75527a527a5379595479937f75597f77527a75517a675379517f75007f7761007901007e81517a7561537a75527a527a5379515479937f75517f77527a75517a6868685179517a75517a75517a75517a7561517a75615c79610079610079827751795179012c947f7551790134947f77517a75517a756161007901007e81517a7561517a7561537953795379537960796079607960796079607960795a795a795a795a79011c795c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a755c7a7561011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a75757575757575757575757501137a01137a01137a01137a01137a01137a01137a01137a014779014779597a597a7575577a577a577a577a577a577a5f79011579a2695f790117799304ffff8f009f695e7905ffffffff009f695f7901157a7501147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a01147a0149796100790117799501197951799f63011979517a75680079517a75517a75610118795179940079011a7a7501197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a01197a00517900a06351796161011f790087616361607961001030313233343536373839616263646566610120009451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120519451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120529451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120539451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120549451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120559451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120569451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120579451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120589451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120599451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205a9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205b9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205c9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205d9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205e9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575756101205f9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120609451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001119451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001129451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001139451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001149451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001159451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001169451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001179451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001189451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a567975757575757561012001199451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011a9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011b9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011c9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011d9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011e9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a5679757575757575610120011f9451945379517951937f7551797f77007961007901007e81517a7561007960965179609756795679537951937f7553797f777e577a75567a567a567a567a567a567a56797556795679527951937f7552797f777e577a75567a567a567a567a567a567a56797575757575755179517a75517a75517a7561015f7e6079610079090000000000000000019f69000061007991630061007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635161007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635261007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635361007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635461007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635561007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635661007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635761007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635861007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635961007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635a61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635b61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635c61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635d61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635e61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635f61007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991636061007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011161007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011261007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011361007900a263007902e703a1670068690142790142790142790142790142790142790142790142790142790142795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c0139790139790139790139790139790139790139790139790139790139795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95012f79012f79012f79012f79012f79012f79012f79012f79012f79012f795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875685279009c630130527a75517a685179517a75517a75517a75617e01207a75011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a011f7a51617568011f795179610079610079090000000000000000019f69000061007991630061007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635161007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635261007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635361007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635461007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635561007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635661007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635761007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635861007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635961007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635a61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635b61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635c61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635d61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635e61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635f61007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991636061007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011161007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011261007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011361007900a263007902e703a1670068690143790143790143790143790143790143790143790143790143790143795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013a79013a79013a79013a79013a79013a79013a79013a79013a79013a795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950130790130790130790130790130790130790130790130790130790130795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875685279009c630130527a75517a685179517a75517a75517a7561247b2270223a226273762d3230222c226f70223a227472616e73666572222c226964223a2253797e09222c22616d74223a227e51797e02227d7e0079126170706c69636174696f6e2f6273762d323061014e79014d797e036f72646100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e014e797e51796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e014f797e52796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e014c797e517a75517a7561517a75517a75517a75517a75616100610079635167010068517a75610121796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e011d79610079009c630100670079686100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a7561517a75617e011a79610079009c630100670079686100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a7561517a75617e5b79517961007982775480517951797e0051807e517a75517a75617e517a7561610079614f00527982775ba2635279577f7551797f77070063036f726451876700686300795793517a750079755279537982777f7551797f776100005279517f75007f77810079014c9f630079537a75527a527a51537993527a75517a670079014c9c635379527f75517f7761007901007e81517a7561537a75527a527a515193537993527a75517a670079014d9c635379537f75517f7761007901007e81517a7561537a75527a527a515293537993527a75517a670079014e9c635379557f75517f7761007901007e81517a7561537a75527a527a515493537993527a75517a674f527a75517a686868685179517a75517a75517a75517a7561007900a0635179517993527a75517a5179755379527951937f7552797f77015079876351795193527a75517a5179755379547982777f7552797f776100005279517f75007f77810079014c9f630079537a75527a527a51537993527a75517a670079014c9c635379527f75517f7761007901007e81517a7561537a75527a527a515193537993527a75517a670079014d9c635379537f75517f7761007901007e81517a7561537a75527a527a515293537993527a75517a670079014e9c635379557f75517f7761007901007e81517a7561537a75527a527a515493537993527a75517a674f527a75517a686868685179517a75517a75517a75517a7561007900a0635279517993537a75527a527a5279755479537951937f7553797f77014d79876352795193537a75527a527a5279755279547a75537a537a537a686875686875685179517a75517a75517a7561007900a0635179527982777f7551797f77527a75517a685179517a75517a75617e00795161007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561517a75517a7561517a7568011279011a7993014f79014e795279614c662097dfd76851bf465e8f715593b217714858bbe9570ff3bd5e33840a34e20ff0262102ba79df5f8ae7604a9830f03c7933028186aede0675a16f025dc4f8be8eec0382201008ce7480da41702918d1ec8e6849ba32b4d65b1e40dc669c31a1e6306b266c000001147e53797e537e517953807e4d2703610079040065cd1d9f690079547a75537a537a537a5179537a75527a527a7575615579014161517957795779210ac407f0e4bd44bfc207355a778b046225a7068fc59ee7eda43ad905aadbffc800206c266b30e6a1319c66dc401e5bd6b432ba49688eecd118297041da8074ce081059795679615679aa0079610079517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01007e81517a75615779567956795679567961537956795479577995939521414136d08c5ed2bf3ba048afe6dcaebafeffffffffffffffffffffffffffffff00517951796151795179970079009f63007952799367007968517a75517a75517a7561527a75517a517951795296a0630079527994527a75517a6853798277527982775379012080517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f517f7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e7c7e01205279947f7754537993527993013051797e527e54797e58797e527e53797e52797e57797e0079517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a75517a756100795779ac517a75517a75517a75517a75517a75517a75517a75517a75517a7561517a75517a756169557961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a7561040065cd1d9f6955796100796100798277517951790128947f755179012c947f77517a75517a756161007901007e81517a7561517a756105ffffffff009f69557961007961007982775179517954947f75517958947f77517a75517a756161007901007e81517a7561517a75615279a2695679a95179876957795779ac77777777777777777e0079537961007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561517a75517a75517a75517a7561014f7901217956796151795179610079610079090000000000000000019f69000061007991630061007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635161007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635261007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635361007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635461007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635561007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635661007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635761007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635861007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635961007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635a61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635b61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635c61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635d61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635e61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991635f61007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a7568756861007991636061007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011161007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011261007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875686100799163011361007900a263007902e703a1670068690147790147790147790147790147790147790147790147790147790147795a5b795a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c013e79013e79013e79013e79013e79013e79013e79013e79013e79013e795a5c795a965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c950134790134790134790134790134790134790134790134790134790134795a5c790164965a977600a269765a9f699451958c6b6c766b796c756b757575757575757575756c95517a7561537951799f6351527a75517a6753795179965a970130517993518054797e547a75537a537a537a756875685279009c630130527a75517a685179517a75517a75517a7561247b2270223a226273762d3230222c226f70223a227472616e73666572222c226964223a2253797e09222c22616d74223a227e51797e02227d7e0079126170706c69636174696f6e2f6273762d3230610152790151797e036f72646100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e0152797e51796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e0153797e52796100798277005179014c9f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a756751790200019f63014c527951615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179030000019f63014d527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f63014e527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7567006968686868007953797e517a75517a75517a75617e0150797e517a75517a7561517a75517a75517a75517a7561537961014a790149797e01147e51797e014a797e0148797e517a75617e00795161007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561517a75517a75517a75517a7561537952797e51797e615e7900a0635d79610148790147797e01147e51797e0148797e0146797e517a75615f7961007958805279610079827700517902fd009f63517951615179517951938000795179827751947f75007f77517a75517a75517a7561517a75675179030000019f6301fd527952615179517951938000795179827751947f75007f77517a75517a75517a75617e517a756751790500000000019f6301fe527954615179517951938000795179827751947f75007f77517a75517a75517a75617e517a75675179090000000000000000019f6301ff527958615179517951938000795179827751947f75007f77517a75517a75517a75617e517a7568686868007953797e517a75517a75517a75617e517a75517a7561670068617e0079aa011879877777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777777")
 )
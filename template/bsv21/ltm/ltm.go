@@ -2,9 +2,37 @@ package ltm
 
 import (
 	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 
+	"github.com/bsv-blockchain/go-sdk/chainhash"
 	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/script/interpreter"
+	"github.com/bsv-blockchain/go-sdk/transaction"
+	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
+
+	"github.com/bsv-blockchain/go-script-templates/template/lockup"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
+)
+
+var (
+	// ErrMultipleChangeOutputs is returned when a transaction has multiple change outputs
+	ErrMultipleChangeOutputs = errors.New("multiple change outputs")
+	// ErrInsufficientSupply is returned when a mint would exceed the remaining supply
+	ErrInsufficientSupply = errors.New("reward exceeds remaining supply")
+	// ErrMissingAddress is returned when a lock or reward address is not supplied
+	ErrMissingAddress = errors.New("lock and reward addresses are required")
+	// ErrZeroLockAmount is returned when a mint locks no satoshis
+	ErrZeroLockAmount = errors.New("lock amount must be greater than zero")
+	// ErrRewardOverflow is returned when the lock amount times the multiplier overflows
+	ErrRewardOverflow = errors.New("reward overflows uint64")
+	// ErrChangeNotP2PKH is returned when the change output is not a P2PKH output
+	ErrChangeNotP2PKH = errors.New("change output is not p2pkh")
+	// ErrMissingSatoshis is returned when the value of the contract output is not supplied
+	ErrMissingSatoshis = errors.New("contract output satoshis not supplied")
 )
 
 type LockToMint struct {
@@ -14,6 +42,29 @@ type LockToMint struct {
 	Multiplier   uint64
 	LockDuration uint64
 	StartHeight  uint64
+
+	// Contract state, required to mint from a deployed contract. An empty Id
+	// means the contract has not been minted from yet: the first mint assigns
+	// the contract's own outpoint as the token id and Max as the supply.
+	Id             string
+	Supply         uint64                // Remaining supply
+	Origin         *transaction.Outpoint // Deployment outpoint the token id refers to
+	LastMintHeight uint32                // Lock time of the most recent mint
+
+	// Outpoint and value of the contract output being spent
+	Txid          []byte
+	Vout          uint32
+	Satoshis      uint64
+	LockingScript *script.Script
+}
+
+// LockToMintUnlocker is a LockToMint with fields for minting
+type LockToMintUnlocker struct {
+	LockToMint
+
+	LockAddress   *script.Address
+	RewardAddress *script.Address
+	LockAmount    uint64
 }
 
 func Decode(s *script.Script) *LockToMint {
@@ -29,7 +80,9 @@ func Decode(s *script.Script) *LockToMint {
 	var err error
 	var op *script.ScriptChunk
 
-	ltm := &LockToMint{}
+	ltm := &LockToMint{
+		LockingScript: s,
+	}
 	if op, err = s.ReadOp(&pos); err != nil {
 		return nil
 	}
@@ -70,5 +123,255 @@ func Decode(s *script.Script) *LockToMint {
 	} else {
 		ltm.StartHeight = number.Val.Uint64()
 	}
+	ltm.decodeState(s, suffix+len(*ltmSuffix))
 	return ltm
 }
+
+// decodeState reads the contract state following the suffix. A contract that
+// has not been minted from has no id yet, and its full Max is available.
+func (l *LockToMint) decodeState(s *script.Script, pos int) {
+	var genesis bool
+	if op, err := s.ReadOp(&pos); err != nil || op.Op != script.OpRETURN {
+		return
+	} else if op, err = s.ReadOp(&pos); err != nil {
+		return
+	} else {
+		genesis = len(op.Data) == 1 && op.Data[0] == 1
+	}
+	if genesis {
+		l.Supply = l.Max
+		return
+	}
+
+	if op, err := s.ReadOp(&pos); err != nil {
+		return
+	} else {
+		l.Id = string(op.Data)
+		l.Origin, _ = transaction.OutpointFromString(l.Id)
+	}
+	if op, err := s.ReadOp(&pos); err != nil {
+		return
+	} else if number, numErr := interpreter.MakeScriptNumber(op.Data, len(op.Data), true, true); numErr == nil {
+		l.Supply = number.Val.Uint64()
+	}
+	if op, err := s.ReadOp(&pos); err != nil {
+		return
+	} else if number, numErr := interpreter.MakeScriptNumber(op.Data, len(op.Data), true, true); numErr == nil {
+		l.LastMintHeight = uint32(number.Val.Uint64()) //nolint:gosec // G115: block heights fit in uint32
+	}
+}
+
+// Lock creates the deployment script for the contract: a deploy+mint
+// inscription for Max tokens followed by the contract in its genesis state.
+func (l *LockToMint) Lock() (*script.Script, error) {
+	deploy, err := json.Marshal(struct {
+		P   string `json:"p"`
+		Op  string `json:"op"`
+		Sym string `json:"sym"`
+		Amt string `json:"amt"`
+		Dec string `json:"dec"`
+	}{"bsv-20", "deploy+mint", l.Symbol, fmt.Sprintf("%d", l.Max), fmt.Sprintf("%d", l.Decimals)})
+	if err != nil {
+		return nil, err
+	}
+	s := buildInscription(deploy)
+	return l.lock(s, true, "", 0, 0), nil
+}
+
+// restate creates the contract output that carries the remaining supply
+// forward after a mint at the given height.
+func (l *LockToMint) restate(id string, supply uint64, height uint32) *script.Script {
+	s := buildInscription(transferJSON(id, supply))
+	return l.lock(s, false, id, supply, height)
+}
+
+func (l *LockToMint) lock(inscription *script.Script, genesis bool, id string, supply uint64, height uint32) *script.Script {
+	s := script.NewFromBytes(append(*inscription, *ltmPrefix...))
+	_ = s.AppendPushData([]byte(l.Symbol))
+	_ = s.AppendPushData(uint64ToBytes(l.Max))
+	if l.Decimals == 0 {
+		_ = s.AppendOpcodes(script.Op0)
+	} else if l.Decimals <= 16 {
+		_ = s.AppendOpcodes(l.Decimals + 0x50)
+	} else {
+		_ = s.AppendPushData([]byte{l.Decimals})
+	}
+	_ = s.AppendPushData(uint64ToBytes(l.Multiplier))
+	_ = s.AppendPushData(uint64ToBytes(l.LockDuration))
+	_ = s.AppendPushData(uint64ToBytes(l.StartHeight))
+	s = script.NewFromBytes(append(*s, *ltmSuffix...))
+
+	state := script.NewFromBytes([]byte{})
+	_ = state.AppendOpcodes(script.OpRETURN)
+	if genesis {
+		_ = state.AppendPushData([]byte{1})
+		_ = state.AppendOpcodes(script.OpFALSE)
+	} else {
+		_ = state.AppendOpcodes(script.OpFALSE)
+		_ = state.AppendPushData([]byte(id))
+	}
+	_ = state.AppendPushData(uint64ToBytes(supply))
+	_ = state.AppendPushData(uint64ToBytes(uint64(height)))
+	stateSize := uint32(len(*state) - 1) //nolint:gosec // G115: len() always returns non-negative
+	stateScript := binary.LittleEndian.AppendUint32(*state, stateSize)
+	stateScript = append(stateScript, 0x00)
+
+	lockingScript := make([]byte, len(*s)+len(stateScript))
+	copy(lockingScript, *s)
+	copy(lockingScript[len(*s):], stateScript)
+	return script.NewFromBytes(lockingScript)
+}
+
+func transferJSON(id string, amt uint64) []byte {
+	return fmt.Appendf(nil, `{"p":"bsv-20","op":"transfer","id":"%s","amt":"%d"}`, id, amt)
+}
+
+func buildInscription(content []byte) *script.Script {
+	lockingScript := script.NewFromBytes([]byte{})
+	_ = lockingScript.AppendOpcodes(script.OpFALSE, script.OpIF)
+	_ = lockingScript.AppendPushData([]byte("ord"))
+	_ = lockingScript.AppendOpcodes(script.Op1)
+	_ = lockingScript.AppendPushData([]byte("application/bsv-20"))
+	_ = lockingScript.AppendOpcodes(script.Op0)
+	_ = lockingScript.AppendPushData(content)
+	_ = lockingScript.AppendOpcodes(script.OpENDIF)
+	return lockingScript
+}
+
+// BuildMintTx builds a transaction minting tokens from the contract at
+// Txid/Vout, which holds Satoshis. lockAmount satoshis are locked to
+// lockAddress until height plus LockDuration, and LockAmount * Multiplier
+// tokens are sent to rewardAddress.
+// The transaction's lock time is set to height, which must not be below
+// StartHeight. Funding inputs must be added before calling Fee and Sign.
+func (l *LockToMint) BuildMintTx(lockAddress, rewardAddress *script.Address, lockAmount uint64, height uint32, changeAddress *script.Address) (*transaction.Transaction, error) {
+	if l.Satoshis == 0 {
+		return nil, ErrMissingSatoshis
+	}
+	id, supply := l.Id, l.Supply
+	txid, err := chainhash.NewHash(l.Txid)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		id = (&transaction.Outpoint{Txid: *txid, Index: l.Vout}).OrdinalString()
+		supply = l.Max
+	}
+	unlock, err := l.Unlock(lockAddress, rewardAddress, lockAmount)
+	if err != nil {
+		return nil, err
+	}
+	reward := lockAmount * l.Multiplier
+	if reward > supply {
+		return nil, ErrInsufficientSupply
+	}
+
+	tx := transaction.NewTransaction()
+	_ = tx.AddInputsFromUTXOs(&transaction.UTXO{
+		TxID:                    txid,
+		Vout:                    l.Vout,
+		LockingScript:           l.LockingScript,
+		Satoshis:                l.Satoshis,
+		UnlockingScriptTemplate: unlock,
+	})
+	tx.Inputs[0].SequenceNumber = 0
+	tx.LockTime = height
+
+	if supply > reward {
+		tx.AddOutput(&transaction.TransactionOutput{
+			LockingScript: l.restate(id, supply-reward, height),
+			Satoshis:      1,
+		})
+	}
+	tx.AddOutput(&transaction.TransactionOutput{
+		LockingScript: lockup.Lock{
+			Address: lockAddress,
+			Until:   height + uint32(l.LockDuration), //nolint:gosec // G115: lock durations are block counts
+		}.Lock(),
+		Satoshis: lockAmount,
+	})
+	rewardScript := buildInscription(transferJSON(id, reward))
+	_ = rewardScript.AppendOpcodes(script.OpDUP, script.OpHASH160)
+	_ = rewardScript.AppendPushData(rewardAddress.PublicKeyHash)
+	_ = rewardScript.AppendOpcodes(script.OpEQUALVERIFY, script.OpCHECKSIG)
+	tx.AddOutput(&transaction.TransactionOutput{
+		LockingScript: rewardScript,
+		Satoshis:      1,
+	})
+	if changeAddress != nil {
+		change := &transaction.TransactionOutput{
+			Change: true,
+		}
+		if change.LockingScript, err = p2pkh.Lock(changeAddress); err != nil {
+			return nil, err
+		}
+		tx.AddOutput(change)
+	}
+
+	return tx, nil
+}
+
+// Unlock returns the unlocking template for a mint locking lockAmount
+// satoshis to lockAddress and rewarding rewardAddress with lockAmount *
+// Multiplier tokens.
+func (l *LockToMint) Unlock(lockAddress, rewardAddress *script.Address, lockAmount uint64) (*LockToMintUnlocker, error) {
+	if lockAddress == nil || rewardAddress == nil {
+		return nil, ErrMissingAddress
+	} else if lockAmount == 0 {
+		return nil, ErrZeroLockAmount
+	} else if l.Multiplier != 0 && lockAmount > math.MaxUint64/l.Multiplier {
+		return nil, ErrRewardOverflow
+	}
+	unlock := &LockToMintUnlocker{
+		LockToMint:    *l,
+		LockAddress:   lockAddress,
+		RewardAddress: rewardAddress,
+		LockAmount:    lockAmount,
+	}
+	return unlock, nil
+}
+
+func (l *LockToMintUnlocker) Sign(tx *transaction.Transaction, inputIndex uint32) (*script.Script, error) {
+	unlockScript := &script.Script{}
+
+	_ = unlockScript.AppendPushData(l.LockAddress.PublicKeyHash)
+	_ = unlockScript.AppendPushData(l.RewardAddress.PublicKeyHash)
+	_ = unlockScript.AppendPushData(uint64ToBytes(l.LockAmount))
+	if preimage, err := tx.CalcInputPreimage(inputIndex, sighash.All|sighash.AnyOneCanPayForkID); err != nil {
+		return nil, err
+	} else {
+		_ = unlockScript.AppendPushData(preimage)
+	}
+	var change *transaction.TransactionOutput
+	for _, output := range tx.Outputs {
+		if output.Change {
+			if change != nil {
+				return nil, ErrMultipleChangeOutputs
+			}
+			change = output
+		}
+	}
+	if change != nil {
+		if change.LockingScript == nil || !change.LockingScript.IsP2PKH() {
+			return nil, ErrChangeNotP2PKH
+		}
+		_ = unlockScript.AppendPushData(uint64ToBytes(change.Satoshis))
+		_ = unlockScript.AppendPushData((*change.LockingScript)[3:23])
+	} else {
+		_ = unlockScript.AppendOpcodes(script.Op0, script.Op0)
+	}
+
+	return unlockScript, nil
+}
+
+func (l *LockToMintUnlocker) EstimateLength(tx *transaction.Transaction, inputIndex uint32) uint32 {
+	amountPrefix, _ := script.PushDataPrefix(uint64ToBytes(l.LockAmount))
+	preimage, _ := tx.CalcInputPreimage(inputIndex, sighash.AnyOneCanPayForkID|sighash.All)
+	preimagePrefix, _ := script.PushDataPrefix(preimage)
+
+	//nolint:gosec // G115: safe conversion of known small values
+	return uint32(42 + // push lock pkh, push reward pkh
+		len(amountPrefix) + len(uint64ToBytes(l.LockAmount)) + // push lock amount
+		len(preimagePrefix) + len(preimage) + // push data preimage
+		30) // push change sats, push change pkh
+}
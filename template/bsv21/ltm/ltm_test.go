@@ -2,17 +2,20 @@ package ltm
 
 import (
 	"encoding/json"
+	"math"
 	"os"
 	"strings"
 	"testing"
 
 	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
 	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/bsv-blockchain/go-sdk/script/interpreter"
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
 	"github.com/stretchr/testify/require"
 
 	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+	"github.com/bsv-blockchain/go-script-templates/template/lockup"
 )
 
 // TestDecodeLTMFromTestVector tests decoding a Lock-to-Mint (LTM) contract from a test vector
@@ -99,11 +102,12 @@ func TestDecodeLTMFromTestVector(t *testing.T) {
 			ltmData.Symbol, ltmData.Max, ltmData.Decimals, ltmData.Multiplier, ltmData.LockDuration, ltmData.StartHeight)
 
 		// Add specific assertions for the expected LTM fields
-		require.Equal(t, "TEST", ltmData.Symbol, "Symbol should be TEST")
-		require.Positive(t, ltmData.Max, "Max should be greater than 0")
-		require.Positive(t, ltmData.Decimals, "Decimals should be greater than 0")
-		require.Positive(t, ltmData.Multiplier, "Multiplier should be greater than 0")
-		require.Positive(t, ltmData.LockDuration, "LockDuration should be greater than 0")
+		require.Equal(t, "BAMBOO", ltmData.Symbol, "Symbol should be BAMBOO")
+		require.Equal(t, uint64(1000000000000000), ltmData.Max, "Max should be 1000000000000000")
+		require.Equal(t, uint8(8), ltmData.Decimals, "Decimals should be 8")
+		require.Equal(t, uint64(2000), ltmData.Multiplier, "Multiplier should be 2000")
+		require.Equal(t, uint64(60000), ltmData.LockDuration, "LockDuration should be 60000")
+		require.Equal(t, uint64(821660), ltmData.StartHeight, "StartHeight should be 821660")
 	} else if ltmJsonData != nil {
 		// Verify the JSON fields match our expectations
 		require.Equal(t, "bsv-20", ltmJsonData["p"], "Protocol should be bsv-20")
@@ -376,3 +380,174 @@ func TestDecode_DecimalsAsData(t *testing.T) {
 	require.NotNil(t, result, "Decode should succeed with data decimals")
 	require.Equal(t, uint8(3), result.Decimals)
 }
+
+// loadDeployTx loads the BAMBOO LTM deployment test vector
+func loadDeployTx(t *testing.T) *transaction.Transaction {
+	t.Helper()
+	hexData, err := os.ReadFile("../testdata/1bff350b55a113f7da23eaba1dc40a7c5b486d3e1017cda79dbe6bd42e001c81.hex")
+	require.NoError(t, err)
+	tx, err := transaction.NewTransactionFromHex(strings.TrimSpace(string(hexData)))
+	require.NoError(t, err)
+	return tx
+}
+
+// TestLockMatchesDeployment verifies Lock reproduces the deployed contract and genesis state
+func TestLockMatchesDeployment(t *testing.T) {
+	deployTx := loadDeployTx(t)
+	deployed := deployTx.Outputs[0].LockingScript
+
+	ltm := Decode(deployed)
+	require.NotNil(t, ltm)
+
+	lockingScript, err := ltm.Lock()
+	require.NoError(t, err)
+
+	// The deployment inscription differs, but the contract and its state must match
+	deployedInsc := inscription.Decode(deployed)
+	require.NotNil(t, deployedInsc)
+	lockedInsc := inscription.Decode(lockingScript)
+	require.NotNil(t, lockedInsc)
+	require.Equal(t, deployedInsc.ScriptSuffix, lockedInsc.ScriptSuffix)
+
+	var deploy map[string]string
+	require.NoError(t, json.Unmarshal(lockedInsc.File.Content, &deploy))
+	require.Equal(t, map[string]string{
+		"p":   "bsv-20",
+		"op":  "deploy+mint",
+		"sym": "BAMBOO",
+		"amt": "1000000000000000",
+		"dec": "8",
+	}, deploy)
+
+	decoded := Decode(lockingScript)
+	require.NotNil(t, decoded)
+	require.Equal(t, ltm.Symbol, decoded.Symbol)
+	require.Equal(t, ltm.Max, decoded.Max)
+	require.Equal(t, ltm.StartHeight, decoded.StartHeight)
+}
+
+// mintAndVerify builds a mint transaction and executes the contract input
+func mintAndVerify(t *testing.T, ltm *LockToMint, prevOutput *transaction.TransactionOutput, lockAddress, rewardAddress *script.Address, lockAmount uint64, height uint32) *transaction.Transaction {
+	t.Helper()
+	tx, err := ltm.BuildMintTx(lockAddress, rewardAddress, lockAmount, height, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign())
+
+	err = interpreter.NewEngine().Execute(
+		interpreter.WithTx(tx, 0, prevOutput),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+// TestBuildMintTx mints from the deployed contract and then from the restated contract
+func TestBuildMintTx(t *testing.T) {
+	deployTx := loadDeployTx(t)
+	ltm := Decode(deployTx.Outputs[0].LockingScript)
+	require.NotNil(t, ltm)
+	require.Empty(t, ltm.Id)
+	require.Nil(t, ltm.Origin)
+	require.Equal(t, ltm.Max, ltm.Supply)
+	ltm.Txid = deployTx.TxID().CloneBytes()
+	ltm.Vout = 0
+	ltm.Satoshis = deployTx.Outputs[0].Satoshis
+
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+
+	// First mint assigns the token id and spends the genesis state
+	tx := mintAndVerify(t, ltm, deployTx.Outputs[0], address, address, 10000, 830000)
+	require.Len(t, tx.Outputs, 3)
+	require.Equal(t, uint32(830000), tx.LockTime)
+
+	tokenID := deployTx.TxID().String() + "_0"
+	lock := lockup.Decode(tx.Outputs[1].LockingScript)
+	require.NotNil(t, lock)
+	require.Equal(t, uint64(10000), tx.Outputs[1].Satoshis)
+	require.Equal(t, uint32(890000), lock.Until)
+	require.Equal(t, address.AddressString, lock.Address.AddressString)
+
+	reward := inscription.Decode(tx.Outputs[2].LockingScript)
+	require.NotNil(t, reward)
+	require.JSONEq(t, `{"p":"bsv-20","op":"transfer","id":"`+tokenID+`","amt":"20000000"}`, string(reward.File.Content))
+
+	// Second mint spends the restated contract
+	restated := Decode(tx.Outputs[0].LockingScript)
+	require.NotNil(t, restated)
+	restated.Txid = tx.TxID().CloneBytes()
+	restated.Vout = 0
+	restated.Satoshis = tx.Outputs[0].Satoshis
+	require.Equal(t, tokenID, restated.Id)
+	require.Equal(t, ltm.Max-20000000, restated.Supply)
+	require.Equal(t, uint32(830000), restated.LastMintHeight)
+	require.NotNil(t, restated.Origin)
+	require.Equal(t, tokenID, restated.Origin.OrdinalString())
+	mintAndVerify(t, restated, tx.Outputs[0], address, address, 500, 830010)
+
+	// The contract input is signed for the value it holds
+	funded := *restated
+	funded.Satoshis = 1000
+	mintAndVerify(t, &funded, &transaction.TransactionOutput{
+		LockingScript: tx.Outputs[0].LockingScript,
+		Satoshis:      1000,
+	}, address, address, 500, 830010)
+	funded.Satoshis = 0
+	_, err = funded.BuildMintTx(address, address, 500, 830010, nil)
+	require.ErrorIs(t, err, ErrMissingSatoshis)
+
+	// Claiming a larger reward than the lock pays for fails the contract
+	badTx, err := restated.BuildMintTx(address, address, 500, 830010, nil)
+	require.NoError(t, err)
+	badTx.Outputs[len(badTx.Outputs)-1].LockingScript = buildInscription(transferJSON(tokenID, 2000000))
+	require.NoError(t, badTx.Sign())
+	err = interpreter.NewEngine().Execute(
+		interpreter.WithTx(badTx, 0, tx.Outputs[0]),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	)
+	require.Error(t, err)
+
+	// Minting more than the remaining supply is rejected
+	restated.Supply = 100
+	_, err = restated.BuildMintTx(address, address, 1, 830010, nil)
+	require.ErrorIs(t, err, ErrInsufficientSupply)
+
+	// Invalid mint parameters are rejected
+	_, err = restated.Unlock(nil, address, 500)
+	require.ErrorIs(t, err, ErrMissingAddress)
+	_, err = restated.Unlock(address, nil, 500)
+	require.ErrorIs(t, err, ErrMissingAddress)
+	_, err = restated.Unlock(address, address, 0)
+	require.ErrorIs(t, err, ErrZeroLockAmount)
+	_, err = restated.Unlock(address, address, math.MaxUint64/restated.Multiplier+1)
+	require.ErrorIs(t, err, ErrRewardOverflow)
+
+	// A change output that is not P2PKH cannot be signed for
+	restated.Supply = ltm.Max
+	changeTx, err := restated.BuildMintTx(address, address, 500, 830010, address)
+	require.NoError(t, err)
+	change := changeTx.Outputs[len(changeTx.Outputs)-1]
+	require.True(t, change.Change)
+	change.LockingScript = script.NewFromBytes([]byte{script.OpTRUE})
+	_, err = changeTx.Inputs[0].UnlockingScriptTemplate.Sign(changeTx, 0)
+	require.ErrorIs(t, err, ErrChangeNotP2PKH)
+	// A token without decimals deploys a contract that can be minted from
+	whole := Decode(deployTx.Outputs[0].LockingScript)
+	whole.Decimals = 0
+	wholeScript, err := whole.Lock()
+	require.NoError(t, err)
+	whole = Decode(wholeScript)
+	require.NotNil(t, whole)
+	require.Zero(t, whole.Decimals)
+	whole.Txid = deployTx.TxID().CloneBytes()
+	whole.Vout = 0
+	whole.Satoshis = 1
+	mintAndVerify(t, whole, &transaction.TransactionOutput{
+		LockingScript: wholeScript,
+		Satoshis:      1,
+	}, address, address, 10000, 830000)
+}
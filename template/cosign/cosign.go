@@ -8,6 +8,8 @@ import (
 	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
 )
 
 var (
@@ -20,7 +22,7 @@ type Cosign struct {
 	Cosigner string `json:"cosigner"`
 }
 
-func Decode(s *script.Script) *Cosign {
+func Decode(s *script.Script, network ...lib.Network) *Cosign {
 	chunks, _ := s.Chunks()
 	for i := range len(chunks) - 6 {
 		if chunks[0+i].Op == script.OpDUP &&
@@ -34,7 +36,7 @@ func Decode(s *script.Script) *Cosign {
 			cosign := &Cosign{
 				Cosigner: hex.EncodeToString(chunks[5+i].Data),
 			}
-			if add, err := script.NewAddressFromPublicKeyHash(chunks[2+i].Data, true); err == nil {
+			if add, err := script.NewAddressFromPublicKeyHash(chunks[2+i].Data, lib.IsMainnet(network...)); err == nil {
 				cosign.Address = add.AddressString
 			}
 			return cosign
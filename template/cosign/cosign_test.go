@@ -9,6 +9,8 @@ import (
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
 )
 
 // TestCosignLock verifies the Lock function to create a cosigner script
@@ -246,3 +248,22 @@ func TestCosignApproverUnlock(t *testing.T) {
 	t.Logf("Transaction approved: %s", tx.String())
 	t.Logf("Approver unlocking script length: %d", len(*unlockingScript))
 }
+
+// TestCosignDecodeNetwork verifies the owner address follows the requested network
+func TestCosignDecodeNetwork(t *testing.T) {
+	ownerKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	cosignerKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+
+	mainnetAddress, err := script.NewAddressFromPublicKey(ownerKey.PubKey(), true)
+	require.NoError(t, err)
+	testnetAddress, err := script.NewAddressFromPublicKey(ownerKey.PubKey(), false)
+	require.NoError(t, err)
+
+	lockScript, err := Lock(mainnetAddress, cosignerKey.PubKey())
+	require.NoError(t, err)
+
+	require.Equal(t, mainnetAddress.AddressString, Decode(lockScript).Address)
+	require.Equal(t, testnetAddress.AddressString, Decode(lockScript, lib.Testnet).Address)
+}
@@ -3,28 +3,131 @@ package inscription
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"unicode/utf8"
 
 	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction"
 )
 
+var (
+	ErrMissingType     = errors.New("inscription content type not supplied")
+	ErrInvalidType     = errors.New("inscription content type must be valid UTF-8 under 256 bytes")
+	ErrMissingContent  = errors.New("inscription content not supplied")
+	ErrContentTooLarge = errors.New("inscription content exceeds maximum size")
+	ErrInvalidEncoding = errors.New("inscription content encoding must be valid UTF-8")
+	ErrInvalidParent   = errors.New("inscription parent not supplied")
+	ErrInvalidChunk    = errors.New("inscription chunk size must not be negative")
+
+	ErrUnknownField   = errors.New("unknown envelope field")
+	ErrMalformedField = errors.New("malformed envelope field")
+	ErrInvalidTag     = errors.New("envelope field tag is not a single byte")
+	ErrUnterminated   = errors.New("envelope is not terminated by OP_ENDIF")
+)
+
+// Envelope field tags
+const (
+	FieldContent         = 0
+	FieldContentType     = 1
+	FieldPointer         = 2
+	FieldParent          = 3
+	FieldMetadata        = 5
+	FieldContentEncoding = 9
+	FieldDelegate        = 11
+)
+
+const (
+	MaxChunkSize   = 520        // Largest push used when splitting field data
+	MaxContentSize = 10_000_000 // Default content limit, SV Node's default maximum standard transaction size
+	maxTypeLength  = 255
+)
+
 type File struct {
-	Hash    []byte `json:"hash"`
-	Size    uint32 `json:"size"`
-	Type    string `json:"type"`
-	Content []byte `json:"-"`
+	Hash     []byte `json:"hash"`
+	Size     uint32 `json:"size"`
+	Type     string `json:"type"`
+	Encoding string `json:"encoding,omitempty"` // Content encoding, e.g. "br" or "gzip"
+	Content  []byte `json:"-"`
 }
 
 type Inscription struct {
-	File         File                  `json:"file,omitempty"`
-	Parent       *transaction.Outpoint `json:"parent,omitempty"`
-	ScriptPrefix []byte                `json:"prefix,omitempty"`
-	ScriptSuffix []byte                `json:"suffix,omitempty"`
+	File         File                    `json:"file,omitempty"`
+	Parent       *transaction.Outpoint   `json:"parent,omitempty"`   // First of Parents
+	Parents      []*transaction.Outpoint `json:"parents,omitempty"`  // Parent inscriptions, in envelope order
+	Delegate     *transaction.Outpoint   `json:"delegate,omitempty"` // Inscription whose content is served in place of this one's
+	Pointer      *uint64                 `json:"pointer,omitempty"`  // Offset of the sat being inscribed within the outputs
+	Metadata     []byte                  `json:"metadata,omitempty"` // CBOR encoded metadata
+	ScriptPrefix []byte                  `json:"prefix,omitempty"`
+	ScriptSuffix []byte                  `json:"suffix,omitempty"`
+
+	// ChunkSize, when positive, splits File.Content into pushes of at most
+	// ChunkSize bytes on Lock. Decode always joins the pushes of a body.
+	ChunkSize int `json:"-"`
+
+	// MaxSize, when positive, is the largest File.Content that Validate, and
+	// so Lock, accepts in place of MaxContentSize
+	MaxSize int `json:"-"`
+}
+
+// Envelope is an inscription located within a script
+type Envelope struct {
+	*Inscription
+	Start int // Offset of the envelope's OP_FALSE
+	End   int // Offset just past the envelope's OP_ENDIF
 }
 
+// Diagnostic describes an envelope field Decode skipped or could not use
+type Diagnostic struct {
+	Pos   int   // Offset of the field's tag, or of the op ending the envelope
+	Field int   // Field tag, -1 if the tag could not be read
+	Err   error // ErrUnknownField, ErrMalformedField, ErrInvalidTag or ErrUnterminated
+}
+
+// DecodeWithDiagnostics decodes the first inscription in scr like Decode and
+// also lists the fields of its envelope that were unknown or malformed.
+func DecodeWithDiagnostics(scr *script.Script) (*Inscription, []Diagnostic) {
+	var diagnostics []Diagnostic
+	if envelope := decodeFrom(scr, 0, &diagnostics); envelope != nil {
+		return envelope.Inscription, diagnostics
+	}
+	return nil, nil
+}
+
+// Decode returns the first inscription in scr, or nil. Any further envelopes
+// are left in ScriptSuffix; use DecodeAll to decode them as well.
 func Decode(scr *script.Script) *Inscription {
+	if envelope := decodeFrom(scr, 0, nil); envelope != nil {
+		return envelope.Inscription
+	}
+	return nil
+}
+
+// DecodeAll returns every inscription envelope in scr, in script order. Each
+// inscription's ScriptPrefix and ScriptSuffix hold the whole script before
+// and after its own envelope.
+func DecodeAll(scr *script.Script) []*Envelope {
+	var envelopes []*Envelope
 	for pos := 0; pos < len(*scr); {
+		envelope := decodeFrom(scr, pos, nil)
+		if envelope == nil {
+			break
+		}
+		envelopes = append(envelopes, envelope)
+		pos = envelope.End
+	}
+	return envelopes
+}
+
+// decodeFrom returns the first envelope starting at or after pos. Problems
+// with its fields are appended to diagnostics, if not nil.
+func decodeFrom(scr *script.Script, pos int, diagnostics *[]Diagnostic) *Envelope {
+	diagnose := func(pos, field int, err error) {
+		if diagnostics != nil {
+			*diagnostics = append(*diagnostics, Diagnostic{Pos: pos, Field: field, Err: err})
+		}
+	}
+	for pos < len(*scr) {
 		startI := pos
 		if op, err := scr.ReadOp(&pos); err != nil {
 			break
@@ -32,65 +135,244 @@ func Decode(scr *script.Script) *Inscription {
 			insc := &Inscription{
 				ScriptPrefix: (*scr)[:startI-2],
 			}
+			envelope := &Envelope{
+				Inscription: insc,
+				Start:       startI - 2,
+			}
 
 		ordLoop:
 			for {
-				var field int
 				var err error
 				var op, op2 *script.ScriptChunk
-				if op, err = scr.ReadOp(&pos); err != nil || op.Op > script.Op16 {
-					return insc
+				tagPos := pos
+				if op, err = scr.ReadOp(&pos); err == nil && op.Op == script.OpENDIF {
+					// An envelope without a body, such as a delegate's
+					insc.ScriptSuffix = (*scr)[pos:]
+					envelope.End = pos
+					return envelope
+				} else if err != nil || op.Op > script.Op16 {
+					diagnose(tagPos, -1, ErrUnterminated)
+					envelope.End = pos
+					return envelope
+				} else if field, ok := fieldTag(op); ok && field == FieldContent {
+					// The tag is followed directly by the body's pushes
+					break ordLoop
 				} else if op2, err = scr.ReadOp(&pos); err != nil || op2.Op > script.Op16 {
-					return insc
-				} else if op.Op > script.OpPUSHDATA4 && op.Op <= script.Op16 {
-					field = int(op.Op) - 80
-				} else if len(op.Data) == 1 {
-					field = int(op.Data[0])
-				} else if len(op.Data) > 1 {
+					diagnose(tagPos, -1, ErrUnterminated)
+					envelope.End = pos
+					return envelope
+				} else if !ok {
+					diagnose(tagPos, -1, ErrInvalidTag)
 					continue
+				} else if err = insc.setField(field, op2.Data); err != nil {
+					diagnose(tagPos, field, err)
 				}
-				switch field {
-				case 0:
-					insc.File.Content = op2.Data
-					insc.File.Size = uint32(len(insc.File.Content)) //nolint:gosec // G115: safe conversion
-					hash := sha256.Sum256(insc.File.Content)
-					insc.File.Hash = hash[:]
-					break ordLoop
-				case 1:
-					if len(op2.Data) < 256 && utf8.Valid(op2.Data) {
-						insc.File.Type = string(op2.Data)
-					}
-				case 3:
-					if len(op2.Data) == 36 {
-						insc.Parent = transaction.NewOutpointFromBytes(op2.Data)
-					}
-				}
-
 			}
+			// Content may be split across any number of pushes, or be empty
 			op, err := scr.ReadOp(&pos)
+			for ; err == nil && op.Op <= script.OpPUSHDATA4; op, err = scr.ReadOp(&pos) {
+				if content := insc.File.Content; content == nil {
+					insc.File.Content = op.Data
+				} else {
+					insc.File.Content = append(content[:len(content):len(content)], op.Data...)
+				}
+			}
+			insc.File.Size = uint32(len(insc.File.Content)) //nolint:gosec // G115: safe conversion
+			hash := sha256.Sum256(insc.File.Content)
+			insc.File.Hash = hash[:]
 			if err != nil || op.Op == script.OpENDIF {
+				if err != nil {
+					diagnose(pos, -1, ErrUnterminated)
+				}
 				insc.ScriptSuffix = (*scr)[pos:]
-				return insc
+				envelope.End = pos
+				return envelope
 			}
 		}
 	}
 	return nil
 }
 
+// fieldTag returns the field number pushed by op. Tags longer than a byte are
+// not recognized.
+func fieldTag(op *script.ScriptChunk) (int, bool) {
+	if op.Op > script.OpPUSHDATA4 && op.Op <= script.Op16 {
+		return int(op.Op) - 80, true
+	} else if len(op.Data) == 1 {
+		return int(op.Data[0]), true
+	}
+	return 0, len(op.Data) == 0
+}
+
+// setField sets the header field identified by tag. Unknown fields and values
+// that are not valid for their field are left unset and reported as
+// ErrUnknownField and ErrMalformedField.
+func (i *Inscription) setField(field int, data []byte) error {
+	switch field {
+	case FieldContentType:
+		if len(data) > maxTypeLength || !utf8.Valid(data) {
+			return ErrMalformedField
+		}
+		i.File.Type = string(data)
+	case FieldPointer:
+		if len(data) > 8 {
+			return ErrMalformedField
+		}
+		var pointer uint64
+		for n, b := range data {
+			pointer |= uint64(b) << (8 * n)
+		}
+		i.Pointer = &pointer
+	case FieldParent:
+		parent := outpointFromField(data)
+		if parent == nil {
+			return ErrMalformedField
+		}
+		i.Parents = append(i.Parents, parent)
+		if i.Parent == nil {
+			i.Parent = parent
+		}
+	case FieldMetadata:
+		i.Metadata = append(i.Metadata, data...)
+	case FieldContentEncoding:
+		if !utf8.Valid(data) {
+			return ErrMalformedField
+		}
+		i.File.Encoding = string(data)
+	case FieldDelegate:
+		if i.Delegate = outpointFromField(data); i.Delegate == nil {
+			return ErrMalformedField
+		}
+	default:
+		return ErrUnknownField
+	}
+	return nil
+}
+
+// outpointFromField parses an inscription id field: a txid followed by a
+// little-endian index, with trailing zero bytes of the index omitted
+func outpointFromField(b []byte) *transaction.Outpoint {
+	if len(b) < 32 || len(b) > 36 {
+		return nil
+	}
+	return transaction.NewOutpointFromBytes(append(b[:len(b):len(b)], make([]byte, 36-len(b))...))
+}
+
+// Lock builds the envelope followed by ScriptSuffix. Parents are taken from
+// Parents, or from Parent when Parents is empty. Metadata longer than
+// MaxChunkSize is split across multiple metadata fields.
 func (i *Inscription) Lock() (*script.Script, error) {
-	s := script.NewFromBytes(i.ScriptPrefix)
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	s := script.NewFromBytes(bytes.Clone(i.ScriptPrefix))
+	s = i.appendEnvelope(s)
+	return script.NewFromBytes(append(*s, i.ScriptSuffix...)), nil
+}
+
+// LockAll stacks the envelopes of inscriptions in order, followed by
+// lockingScript. The inscriptions' own ScriptPrefix and ScriptSuffix are not
+// used.
+func LockAll(inscriptions []*Inscription, lockingScript *script.Script) (*script.Script, error) {
+	s := &script.Script{}
+	for _, insc := range inscriptions {
+		if err := insc.Validate(); err != nil {
+			return nil, err
+		}
+		s = insc.appendEnvelope(s)
+	}
+	if lockingScript != nil {
+		s = script.NewFromBytes(append(*s, *lockingScript...))
+	}
+	return s, nil
+}
+
+// Validate checks that the inscription can be encoded so that Decode returns
+// the same fields. An inscription with a Delegate may omit its type and
+// content, which are taken from the delegate. Content larger than MaxSize, or
+// MaxContentSize when MaxSize is not set, is rejected with ErrContentTooLarge.
+func (i *Inscription) Validate() error {
+	if i.Delegate == nil {
+		if i.File.Type == "" {
+			return ErrMissingType
+		} else if len(i.File.Content) == 0 {
+			return ErrMissingContent
+		}
+	}
+	if len(i.File.Type) > maxTypeLength || !utf8.ValidString(i.File.Type) {
+		return ErrInvalidType
+	} else if len(i.File.Content) > i.maxSize() {
+		return ErrContentTooLarge
+	} else if !utf8.ValidString(i.File.Encoding) {
+		return ErrInvalidEncoding
+	} else if i.ChunkSize < 0 {
+		return ErrInvalidChunk
+	}
+	for _, parent := range i.Parents {
+		if parent == nil {
+			return ErrInvalidParent
+		}
+	}
+	return nil
+}
+
+// maxSize returns the content size limit applied by Validate
+func (i *Inscription) maxSize() int {
+	if i.MaxSize > 0 {
+		return i.MaxSize
+	}
+	return MaxContentSize
+}
+
+func (i *Inscription) appendEnvelope(s *script.Script) *script.Script {
 	_ = s.AppendOpcodes(script.Op0, script.OpIF)
 	_ = s.AppendPushData([]byte("ord"))
 
-	// Add file type if available
-	// if i.File.Type != "" {
-	_ = s.AppendOpcodes(script.Op1)
-	_ = s.AppendPushDataString(i.File.Type)
+	if i.File.Type != "" {
+		_ = s.AppendOpcodes(script.Op1)
+		_ = s.AppendPushDataString(i.File.Type)
+	}
+
+	if i.Pointer != nil {
+		pointer := binary.LittleEndian.AppendUint64(nil, *i.Pointer)
+		_ = s.AppendOpcodes(script.Op2)
+		_ = s.AppendPushData(bytes.TrimRight(pointer, "\x00"))
+	}
+	parents := i.Parents
+	if len(parents) == 0 && i.Parent != nil {
+		parents = []*transaction.Outpoint{i.Parent}
+	}
+	for _, parent := range parents {
+		_ = s.AppendOpcodes(script.Op3)
+		_ = s.AppendPushData(parent.Bytes())
+	}
+	for metadata := i.Metadata; len(metadata) > 0; {
+		chunk := metadata[:min(len(metadata), MaxChunkSize)]
+		metadata = metadata[len(chunk):]
+		_ = s.AppendOpcodes(script.Op5)
+		_ = s.AppendPushData(chunk)
+	}
+	if i.File.Encoding != "" {
+		_ = s.AppendOpcodes(script.Op9)
+		_ = s.AppendPushDataString(i.File.Encoding)
+	}
+	if i.Delegate != nil {
+		_ = s.AppendOpcodes(script.Op11)
+		_ = s.AppendPushData(i.Delegate.Bytes())
+	}
 
 	// Add content
 	_ = s.AppendOpcodes(script.Op0)
-	_ = s.AppendPushData(i.File.Content)
+	if i.ChunkSize > 0 {
+		for content := i.File.Content; len(content) > 0; {
+			chunk := content[:min(len(content), i.ChunkSize)]
+			content = content[len(chunk):]
+			_ = s.AppendPushData(chunk)
+		}
+	} else {
+		_ = s.AppendPushData(i.File.Content)
+	}
 
 	_ = s.AppendOpcodes(script.OpENDIF)
-	return script.NewFromBytes(append(*s, i.ScriptSuffix...)), nil
+	return s
 }
@@ -1,9 +1,15 @@
 package inscription
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
 	"testing"
 
+	"github.com/bsv-blockchain/go-sdk/chainhash"
 	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/bsv-blockchain/go-sdk/transaction"
 )
 
 func TestDecode_InvalidScript(t *testing.T) {
@@ -52,3 +58,499 @@ func TestRoundTrip_LockDecode(t *testing.T) {
 		t.Errorf("File.Content mismatch: got %q, want %q", string(decoded.File.Content), string(insc.File.Content))
 	}
 }
+
+func TestRoundTrip_EnvelopeFields(t *testing.T) {
+	parent1 := &transaction.Outpoint{Txid: chainhash.Hash{1}, Index: 2}
+	parent2 := &transaction.Outpoint{Txid: chainhash.Hash{3}, Index: 0}
+	delegate := &transaction.Outpoint{Txid: chainhash.Hash{4}, Index: 256}
+	pointer := uint64(1000)
+	metadata := bytes.Repeat([]byte{0xa1}, MaxChunkSize+10)
+	insc := &Inscription{
+		File: File{
+			Type:     "text/html",
+			Encoding: "br",
+			Content:  []byte("<p>child</p>"),
+		},
+		Parents:      []*transaction.Outpoint{parent1, parent2},
+		Delegate:     delegate,
+		Pointer:      &pointer,
+		Metadata:     metadata,
+		ScriptSuffix: []byte{script.OpTRUE},
+	}
+	s, err := insc.Lock()
+	if err != nil {
+		t.Fatalf("Lock error: %v", err)
+	}
+	decoded := Decode(s)
+	if decoded == nil {
+		t.Fatalf("Decode failed, got nil")
+	}
+	if decoded.File.Type != "text/html" || decoded.File.Encoding != "br" || string(decoded.File.Content) != "<p>child</p>" {
+		t.Errorf("File mismatch: got %+v", decoded.File)
+	}
+	if len(decoded.Parents) != 2 || !decoded.Parents[0].Equal(parent1) || !decoded.Parents[1].Equal(parent2) {
+		t.Errorf("Parents mismatch: got %v", decoded.Parents)
+	}
+	if decoded.Parent == nil || !decoded.Parent.Equal(parent1) {
+		t.Errorf("Parent mismatch: got %v", decoded.Parent)
+	}
+	if decoded.Delegate == nil || !decoded.Delegate.Equal(delegate) {
+		t.Errorf("Delegate mismatch: got %v", decoded.Delegate)
+	}
+	if decoded.Pointer == nil || *decoded.Pointer != pointer {
+		t.Errorf("Pointer mismatch: got %v", decoded.Pointer)
+	}
+	if !bytes.Equal(decoded.Metadata, metadata) {
+		t.Errorf("Metadata mismatch: got %d bytes, want %d", len(decoded.Metadata), len(metadata))
+	}
+	if !bytes.Equal(decoded.ScriptSuffix, []byte{script.OpTRUE}) {
+		t.Errorf("ScriptSuffix mismatch: got %x", decoded.ScriptSuffix)
+	}
+}
+
+func TestLock_SingleParent(t *testing.T) {
+	parent := &transaction.Outpoint{Txid: chainhash.Hash{9}, Index: 1}
+	zero := uint64(0)
+	s, err := (&Inscription{File: File{Type: "text/plain", Content: []byte("x")}, Parent: parent, Pointer: &zero}).Lock()
+	if err != nil {
+		t.Fatalf("Lock error: %v", err)
+	}
+	decoded := Decode(s)
+	if decoded == nil || decoded.Parent == nil || !decoded.Parent.Equal(parent) || len(decoded.Parents) != 1 {
+		t.Fatalf("Parent not round-tripped: got %+v", decoded)
+	}
+	if decoded.Pointer == nil || *decoded.Pointer != 0 {
+		t.Errorf("Pointer mismatch: got %v", decoded.Pointer)
+	}
+}
+
+func TestDecode_TrimmedParent(t *testing.T) {
+	s := script.NewFromBytes([]byte{})
+	_ = s.AppendOpcodes(script.Op0, script.OpIF)
+	_ = s.AppendPushData([]byte("ord"))
+	_ = s.AppendOpcodes(script.Op3)
+	_ = s.AppendPushData(bytes.Repeat([]byte{7}, 32)) // index 0 with trailing zeros omitted
+	_ = s.AppendOpcodes(script.Op0)
+	_ = s.AppendPushData([]byte("x"))
+	_ = s.AppendOpcodes(script.OpENDIF)
+	decoded := Decode(s)
+	if decoded == nil || decoded.Parent == nil || decoded.Parent.Index != 0 || decoded.Parent.Txid[0] != 7 {
+		t.Fatalf("trimmed parent not decoded: got %+v", decoded)
+	}
+}
+
+func TestDecodeAll_Stacked(t *testing.T) {
+	first := &Inscription{File: File{Type: "text/plain", Content: []byte("first")}}
+	second := &Inscription{File: File{Type: "application/json", Content: []byte(`{"n":2}`)}}
+	suffix := script.NewFromBytes([]byte{script.OpDUP, script.OpDROP, script.OpTRUE})
+	s, err := LockAll([]*Inscription{first, second}, suffix)
+	if err != nil {
+		t.Fatalf("LockAll error: %v", err)
+	}
+	original := bytes.Clone(*s)
+
+	envelopes := DecodeAll(s)
+	if len(envelopes) != 2 {
+		t.Fatalf("expected 2 envelopes, got %d", len(envelopes))
+	}
+	if string(envelopes[0].File.Content) != "first" || string(envelopes[1].File.Content) != `{"n":2}` {
+		t.Errorf("content mismatch: got %q and %q", envelopes[0].File.Content, envelopes[1].File.Content)
+	}
+	if envelopes[0].Start != 0 || envelopes[1].Start != envelopes[0].End {
+		t.Errorf("offset mismatch: got %d-%d and %d-%d", envelopes[0].Start, envelopes[0].End, envelopes[1].Start, envelopes[1].End)
+	}
+	if !bytes.Equal((*s)[envelopes[1].End:], *suffix) {
+		t.Errorf("expected locking script after last envelope, got %x", (*s)[envelopes[1].End:])
+	}
+	if !bytes.Equal(envelopes[1].ScriptPrefix, (*s)[:envelopes[1].Start]) || !bytes.Equal(envelopes[1].ScriptSuffix, *suffix) {
+		t.Errorf("prefix or suffix mismatch for second envelope")
+	}
+
+	// Decode sees the first envelope, with the rest in its suffix
+	decoded := Decode(s)
+	if decoded == nil || string(decoded.File.Content) != "first" || !bytes.Equal(decoded.ScriptSuffix, (*s)[envelopes[0].End:]) {
+		t.Fatalf("Decode mismatch: got %+v", decoded)
+	}
+
+	// Re-locking a decoded envelope leaves the source script untouched
+	if _, err = envelopes[1].Lock(); err != nil {
+		t.Fatalf("Lock error: %v", err)
+	}
+	if !bytes.Equal(*s, original) {
+		t.Errorf("source script modified by Lock")
+	}
+
+	if envelopes := DecodeAll(suffix); len(envelopes) != 0 {
+		t.Errorf("expected no envelopes, got %d", len(envelopes))
+	}
+}
+
+func TestRoundTrip_ChunkedContent(t *testing.T) {
+	content := make([]byte, 3*MaxChunkSize+17)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	insc := &Inscription{
+		File:         File{Type: "image/png", Content: content},
+		ScriptSuffix: []byte{script.OpTRUE},
+		ChunkSize:    MaxChunkSize,
+	}
+	s, err := insc.Lock()
+	if err != nil {
+		t.Fatalf("Lock error: %v", err)
+	}
+
+	var sizes []int
+	for pos := 0; pos < len(*s); {
+		op, err := s.ReadOp(&pos)
+		if err != nil {
+			t.Fatalf("ReadOp error: %v", err)
+		}
+		if len(op.Data) > len("image/png") {
+			sizes = append(sizes, len(op.Data))
+		}
+	}
+	if len(sizes) != 4 || sizes[0] != MaxChunkSize || sizes[3] != 17 {
+		t.Errorf("expected content in 4 pushes, got sizes %v", sizes)
+	}
+
+	original := bytes.Clone(*s)
+	decoded := Decode(s)
+	if decoded == nil {
+		t.Fatalf("Decode failed, got nil")
+	}
+	if !bytes.Equal(decoded.File.Content, content) {
+		t.Errorf("content mismatch: got %d bytes, want %d", len(decoded.File.Content), len(content))
+	}
+	hash := sha256.Sum256(content)
+	if decoded.File.Size != uint32(len(content)) || !bytes.Equal(decoded.File.Hash, hash[:]) {
+		t.Errorf("size or hash not computed over the whole body: got %d %x", decoded.File.Size, decoded.File.Hash)
+	}
+	if !bytes.Equal(decoded.ScriptSuffix, []byte{script.OpTRUE}) {
+		t.Errorf("ScriptSuffix mismatch: got %x", decoded.ScriptSuffix)
+	}
+	if !bytes.Equal(*s, original) {
+		t.Errorf("source script modified by Decode")
+	}
+}
+
+func TestDecodeReader(t *testing.T) {
+	content := bytes.Repeat([]byte("streamed "), 200)
+	parent := &transaction.Outpoint{Txid: chainhash.Hash{5}, Index: 1}
+	insc := &Inscription{
+		File:         File{Type: "text/plain", Encoding: "gzip", Content: content},
+		Parent:       parent,
+		ScriptPrefix: []byte{script.OpTRUE, script.OpDROP},
+		ScriptSuffix: []byte{script.OpTRUE},
+		ChunkSize:    100,
+	}
+	s, err := insc.Lock()
+	if err != nil {
+		t.Fatalf("Lock error: %v", err)
+	}
+	expected := Decode(s)
+
+	var out bytes.Buffer
+	streamed, err := DecodeReader(bytes.NewReader(*s), &out)
+	if err != nil {
+		t.Fatalf("DecodeReader error: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), content) {
+		t.Errorf("streamed content mismatch: got %d bytes, want %d", out.Len(), len(content))
+	}
+	if streamed.File.Content != nil {
+		t.Errorf("expected content not to be retained")
+	}
+	if streamed.File.Type != expected.File.Type || streamed.File.Encoding != expected.File.Encoding ||
+		streamed.File.Size != expected.File.Size || !bytes.Equal(streamed.File.Hash, expected.File.Hash) {
+		t.Errorf("file mismatch: got %+v, want %+v", streamed.File, expected.File)
+	}
+	if streamed.Parent == nil || !streamed.Parent.Equal(parent) {
+		t.Errorf("Parent mismatch: got %v", streamed.Parent)
+	}
+
+	// A reader that reads byte by byte is left just past the envelope
+	r := bytes.NewReader(*s)
+	if _, err = DecodeReader(r, nil); err != nil {
+		t.Fatalf("DecodeReader error: %v", err)
+	}
+	if rest, _ := io.ReadAll(r); !bytes.Equal(rest, expected.ScriptSuffix) {
+		t.Errorf("reader not positioned after the envelope: %x remain", rest)
+	}
+
+	// A nil writer skips the content but still sizes and hashes it
+	skipped, err := DecodeReader(bytes.NewReader(*s), nil)
+	if err != nil {
+		t.Fatalf("DecodeReader error: %v", err)
+	}
+	if skipped.File.Size != expected.File.Size || !bytes.Equal(skipped.File.Hash, expected.File.Hash) {
+		t.Errorf("skipped content not hashed: got %+v", skipped.File)
+	}
+
+	if _, err = DecodeReader(bytes.NewReader([]byte{script.OpDUP, script.OpDROP}), nil); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err = DecodeReader(bytes.NewReader((*s)[:len(*s)-400]), io.Discard); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF for truncated script, got %v", err)
+	}
+	bogus := []byte{script.OpPUSHDATA4, 0xff, 0xff, 0xff, 0xff, 0x00}
+	if _, err = DecodeReader(bytes.NewReader(bogus), nil); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF for oversized push, got %v", err)
+	}
+
+	// An envelope needs OP_0 OP_IF in front of the marker, even at the start
+	headless := &script.Script{}
+	_ = headless.AppendOpcodes(script.OpIF)
+	_ = headless.AppendPushData([]byte("ord"))
+	_ = headless.AppendOpcodes(script.Op0)
+	_ = headless.AppendPushData([]byte("body"))
+	_ = headless.AppendOpcodes(script.OpENDIF)
+	if Decode(headless) != nil {
+		t.Errorf("expected Decode to reject a script starting with OP_IF")
+	}
+	if _, err = DecodeReader(bytes.NewReader(*headless), nil); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound for a script starting with OP_IF, got %v", err)
+	}
+
+	// A body not closed by OP_ENDIF is rejected, as Decode rejects it
+	unclosed := &script.Script{}
+	_ = unclosed.AppendOpcodes(script.Op0, script.OpIF)
+	_ = unclosed.AppendPushData([]byte("ord"))
+	_ = unclosed.AppendOpcodes(script.Op1)
+	_ = unclosed.AppendPushData([]byte("text/plain"))
+	_ = unclosed.AppendOpcodes(script.Op0)
+	_ = unclosed.AppendPushData([]byte("unclosed"))
+	_ = unclosed.AppendOpcodes(script.OpDUP, script.OpENDIF)
+	if Decode(unclosed) != nil {
+		t.Errorf("expected Decode to reject an unclosed body")
+	}
+	if _, err = DecodeReader(bytes.NewReader(*unclosed), nil); err != ErrUnterminated {
+		t.Errorf("expected ErrUnterminated for unclosed body, got %v", err)
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := map[string]string{
+		"\x89PNG\r\n\x1a\n\x00\x00":                                     TypePNG,
+		"\xff\xd8\xff\xe0\x00\x10JFIF":                                  TypeJPEG,
+		"GIF89a\x01\x00":                                                TypeGIF,
+		"RIFF\x00\x00\x00\x00WEBPVP8 ":                                  TypeWebP,
+		`<svg xmlns="http://www.w3.org/2000/svg"/>`:                     TypeSVG,
+		"<?xml version=\"1.0\"?>\n<svg></svg>":                          TypeSVG,
+		"\xef\xbb\xbf  <!DOCTYPE html><html></html>":                    TypeHTML,
+		"<script>alert(1)</script>":                                     TypeHTML,
+		"<div>fragment</div>":                                           TypeHTML,
+		"<!-- comment -->\n<p>hi</p>":                                   TypeHTML,
+		"<!DOCTYPE svg PUBLIC \"-//W3C//DTD SVG 1.1//EN\" \"x\"><svg/>": TypeSVG,
+		"<!-- made by hand --><svg></svg>":                              TypeSVG,
+		"<?xml version=\"1.0\"?><rss></rss>":                            TypeText,
+		` {"p":"bsv-20"}`:                                               TypeJSON,
+		"[1, 2]":                                                        TypeJSON,
+		"\xef\xbb\xbf{\"a\":1}":                                         TypeJSON,
+		"{not json":                                                     TypeText,
+		"hello world\n":                                                 TypeText,
+		"\x00\x01\x02":                                                  "",
+		"\xff\xfe":                                                      "",
+	}
+	for content, expected := range tests {
+		if detected := DetectContentType([]byte(content)); detected != expected {
+			t.Errorf("DetectContentType(%q) = %q, want %q", content, detected, expected)
+		}
+	}
+}
+
+func TestValidateType(t *testing.T) {
+	tests := []struct {
+		declared string
+		content  string
+		detected string
+		mismatch bool
+	}{
+		{"image/png", "\x89PNG\r\n\x1a\n", TypePNG, false},
+		{"image/jpg", "\xff\xd8\xff", TypeJPEG, false},
+		{"image/png", "<html><body>gotcha</body></html>", TypeHTML, true},
+		{"image/png", "GIF89a", TypeGIF, true},
+		{"image/svg+xml", "plain text", TypeText, true},
+		{"text/plain", "<!doctype html>", TypeHTML, true},
+		{"text/html", "<div>fragment</div>", TypeHTML, false},
+		{"text/html", "<style>p { color: red }</style>", TypeHTML, false},
+		{"text/html", `<meta charset="utf-8"><p>hi</p>`, TypeHTML, false},
+		{"text/html", "<!-- header -->\n<p>hi</p>", TypeHTML, false},
+		{"image/svg+xml", "<!-- icon -->\n<svg></svg>", TypeSVG, false},
+		{"image/svg+xml", "<?xml version=\"1.0\"?>\n<!DOCTYPE svg PUBLIC \"-//W3C//DTD SVG 1.1//EN\" \"http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd\">\n<svg></svg>", TypeSVG, false},
+		{"text/html; charset=utf-8", "<!doctype html>", TypeHTML, false},
+		{"text/plain;charset=utf-8", "hello", TypeText, false},
+		{"application/bsv-20", `{"p":"bsv-20"}`, TypeJSON, false},
+		{"text/markdown", "# title", TypeText, false},
+		{"application/json", "not json", TypeText, false},
+		{"model/gltf-binary", "glTF\x02\x00\x00\x00", "", false},
+	}
+	for _, tt := range tests {
+		f := &File{Type: tt.declared, Content: []byte(tt.content)}
+		detected, err := f.ValidateType()
+		if detected != tt.detected {
+			t.Errorf("%s %q: detected %q, want %q", tt.declared, tt.content, detected, tt.detected)
+		}
+		if (err == ErrTypeMismatch) != tt.mismatch {
+			t.Errorf("%s %q: got error %v, want mismatch %v", tt.declared, tt.content, err, tt.mismatch)
+		}
+	}
+}
+
+func TestLock_Validation(t *testing.T) {
+	delegate := &transaction.Outpoint{Txid: chainhash.Hash{2}}
+	tests := []struct {
+		name string
+		insc *Inscription
+		err  error
+	}{
+		{"missing type", &Inscription{File: File{Content: []byte("x")}}, ErrMissingType},
+		{"missing content", &Inscription{File: File{Type: "text/plain"}}, ErrMissingContent},
+		{"long type", &Inscription{File: File{Type: string(bytes.Repeat([]byte("a"), 256)), Content: []byte("x")}}, ErrInvalidType},
+		{"non-utf8 type", &Inscription{File: File{Type: "\xff", Content: []byte("x")}}, ErrInvalidType},
+		{"non-utf8 encoding", &Inscription{File: File{Type: "text/plain", Encoding: "\xff", Content: []byte("x")}}, ErrInvalidEncoding},
+		{"nil parent", &Inscription{File: File{Type: "text/plain", Content: []byte("x")}, Parents: []*transaction.Outpoint{nil}}, ErrInvalidParent},
+		{"negative chunk size", &Inscription{File: File{Type: "text/plain", Content: []byte("x")}, ChunkSize: -1}, ErrInvalidChunk},
+		{"default size limit", &Inscription{File: File{Type: "text/plain", Content: make([]byte, MaxContentSize+1)}}, ErrContentTooLarge},
+		{"custom size limit", &Inscription{File: File{Type: "text/plain", Content: []byte("hello")}, MaxSize: 4}, ErrContentTooLarge},
+		{"delegate", &Inscription{Delegate: delegate}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := tt.insc.Lock()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+			if _, err = LockAll([]*Inscription{tt.insc}, nil); !errors.Is(err, tt.err) {
+				t.Fatalf("LockAll: expected %v, got %v", tt.err, err)
+			}
+			if tt.err == nil {
+				decoded := Decode(s)
+				if decoded == nil || decoded.Delegate == nil || !decoded.Delegate.Equal(delegate) || decoded.File.Type != "" {
+					t.Errorf("delegate not round-tripped: got %+v", decoded)
+				}
+			}
+		})
+	}
+
+	if _, err := (&Inscription{File: File{Type: "text/plain", Content: []byte("hello")}, MaxSize: 5}).Lock(); err != nil {
+		t.Errorf("content within MaxSize rejected: %v", err)
+	}
+}
+
+func TestDecodeWithDiagnostics(t *testing.T) {
+	s := script.NewFromBytes([]byte{})
+	_ = s.AppendOpcodes(script.OpTRUE, script.Op0, script.OpIF)
+	_ = s.AppendPushData([]byte("ord"))
+	_ = s.AppendOpcodes(script.Op1)
+	_ = s.AppendPushData([]byte("text/plain"))
+	unknownPos := len(*s)
+	_ = s.AppendOpcodes(script.Op15)
+	_ = s.AppendPushData([]byte("future"))
+	malformedPos := len(*s)
+	_ = s.AppendOpcodes(script.Op3)
+	_ = s.AppendPushData([]byte("short"))
+	tagPos := len(*s)
+	_ = s.AppendPushData([]byte("xx"))
+	_ = s.AppendPushData([]byte("value"))
+	_ = s.AppendOpcodes(script.Op0)
+	_ = s.AppendPushData([]byte("hello"))
+	_ = s.AppendOpcodes(script.OpENDIF)
+
+	insc, diagnostics := DecodeWithDiagnostics(s)
+	if insc == nil || string(insc.File.Content) != "hello" || insc.File.Type != "text/plain" || insc.Parent != nil {
+		t.Fatalf("unexpected inscription: %+v", insc)
+	}
+	expected := []Diagnostic{
+		{Pos: unknownPos, Field: 15, Err: ErrUnknownField},
+		{Pos: malformedPos, Field: FieldParent, Err: ErrMalformedField},
+		{Pos: tagPos, Field: -1, Err: ErrInvalidTag},
+	}
+	if len(diagnostics) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %+v", len(expected), diagnostics)
+	}
+	for i, d := range diagnostics {
+		if d != expected[i] {
+			t.Errorf("diagnostic %d: got %+v, want %+v", i, d, expected[i])
+		}
+	}
+
+	// A truncated envelope is reported as unterminated
+	truncated := script.NewFromBytes((*s)[:tagPos])
+	if _, diagnostics = DecodeWithDiagnostics(truncated); len(diagnostics) != 3 || diagnostics[2].Err != ErrUnterminated {
+		t.Errorf("expected unterminated diagnostic, got %+v", diagnostics)
+	}
+
+	if insc, diagnostics = DecodeWithDiagnostics(script.NewFromBytes([]byte{script.OpTRUE})); insc != nil || diagnostics != nil {
+		t.Errorf("expected nothing for script without envelope")
+	}
+}
+
+func TestDecode_BodylessDelegate(t *testing.T) {
+	delegate := &transaction.Outpoint{Txid: chainhash.Hash{3}, Index: 1}
+	suffix := &script.Script{}
+	_ = suffix.AppendOpcodes(script.OpDUP, script.OpHASH160)
+	_ = suffix.AppendPushData(bytes.Repeat([]byte{0x01}, 20))
+	_ = suffix.AppendOpcodes(script.OpEQUALVERIFY, script.OpCHECKSIG)
+
+	s := &script.Script{}
+	_ = s.AppendOpcodes(script.Op0, script.OpIF)
+	_ = s.AppendPushData([]byte("ord"))
+	_ = s.AppendOpcodes(script.Op11)
+	_ = s.AppendPushData(delegate.Bytes())
+	_ = s.AppendOpcodes(script.OpENDIF)
+	end := len(*s)
+	*s = append(*s, *suffix...)
+
+	insc, diagnostics := DecodeWithDiagnostics(s)
+	if insc == nil || insc.Delegate == nil || !insc.Delegate.Equal(delegate) {
+		t.Fatalf("delegate not decoded: %+v", insc)
+	}
+	if len(diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %+v", diagnostics)
+	}
+	if !bytes.Equal(insc.ScriptSuffix, *suffix) {
+		t.Errorf("ScriptSuffix mismatch: got %x, want %x", insc.ScriptSuffix, *suffix)
+	}
+	if envelopes := DecodeAll(s); len(envelopes) != 1 || envelopes[0].End != end {
+		t.Errorf("unexpected envelopes: %+v", envelopes)
+	}
+}
+
+func TestDecode_EmptyBody(t *testing.T) {
+	suffix := &script.Script{}
+	_ = suffix.AppendOpcodes(script.OpDUP, script.OpHASH160)
+	_ = suffix.AppendPushData(bytes.Repeat([]byte{0x01}, 20))
+	_ = suffix.AppendOpcodes(script.OpEQUALVERIFY, script.OpCHECKSIG)
+
+	s := &script.Script{}
+	_ = s.AppendOpcodes(script.Op0, script.OpIF)
+	_ = s.AppendPushData([]byte("ord"))
+	_ = s.AppendOpcodes(script.Op1)
+	_ = s.AppendPushData([]byte("text/plain"))
+	_ = s.AppendOpcodes(script.Op0, script.OpENDIF)
+	*s = append(*s, *suffix...)
+
+	insc, diagnostics := DecodeWithDiagnostics(s)
+	if insc == nil {
+		t.Fatal("expected an inscription")
+	}
+	if len(diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %+v", diagnostics)
+	}
+	if !bytes.Equal(insc.ScriptSuffix, *suffix) {
+		t.Errorf("ScriptSuffix mismatch: got %x, want %x", insc.ScriptSuffix, *suffix)
+	}
+	hash := sha256.Sum256(nil)
+	if insc.File.Type != "text/plain" || insc.File.Size != 0 || !bytes.Equal(insc.File.Hash, hash[:]) {
+		t.Errorf("file mismatch: got %+v", insc.File)
+	}
+
+	streamed, err := DecodeReader(bytes.NewReader(*s), nil)
+	if err != nil {
+		t.Fatalf("DecodeReader error: %v", err)
+	}
+	if streamed.File.Size != insc.File.Size || !bytes.Equal(streamed.File.Hash, insc.File.Hash) {
+		t.Errorf("DecodeReader mismatch: got %+v, want %+v", streamed.File, insc.File)
+	}
+}
@@ -0,0 +1,134 @@
+package inscription
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrTypeMismatch = errors.New("declared content type does not match content")
+
+// Content types recognized by DetectContentType
+const (
+	TypePNG  = "image/png"
+	TypeJPEG = "image/jpeg"
+	TypeGIF  = "image/gif"
+	TypeWebP = "image/webp"
+	TypeSVG  = "image/svg+xml"
+	TypeJSON = "application/json"
+	TypeHTML = "text/html"
+	TypeText = "text/plain"
+)
+
+// DetectContentType returns the type of content detected from its magic bytes
+// or markup, or "" if it is not one of the recognized types. Leading comments,
+// doctypes and XML declarations are skipped before markup is matched, and
+// text starting with any tag is taken to be HTML unless the tag is <svg>.
+func DetectContentType(content []byte) string {
+	switch {
+	case bytes.HasPrefix(content, []byte("\x89PNG\r\n\x1a\n")):
+		return TypePNG
+	case bytes.HasPrefix(content, []byte{0xff, 0xd8, 0xff}):
+		return TypeJPEG
+	case bytes.HasPrefix(content, []byte("GIF87a")), bytes.HasPrefix(content, []byte("GIF89a")):
+		return TypeGIF
+	case len(content) >= 12 && bytes.HasPrefix(content, []byte("RIFF")) && bytes.Equal(content[8:12], []byte("WEBP")):
+		return TypeWebP
+	case !utf8.Valid(content):
+		return ""
+	}
+
+	text := bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	rest, doctype, xmlDecl, markup := skipPrologue(text)
+	switch {
+	case strings.HasPrefix(doctype, "html"):
+		return TypeHTML
+	case strings.HasPrefix(doctype, "svg"), hasPrefixFold(rest, "<svg"):
+		return TypeSVG
+	case xmlDecl:
+		// XML other than SVG is treated as text
+	case markup && len(rest) == 0, len(rest) > 1 && rest[0] == '<' && isASCIILetter(rest[1]):
+		return TypeHTML
+	}
+	if !markup && len(rest) > 0 && (rest[0] == '{' || rest[0] == '[') && json.Valid(text) {
+		return TypeJSON
+	}
+	for _, r := range string(content) {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return ""
+		}
+	}
+	return TypeText
+}
+
+// ValidateType checks the declared Type against the type detected from
+// Content, returning the detected type. Images and HTML must be declared as
+// exactly what they are, and content declared as an image or HTML must be
+// one; other textual content may be declared as any non-image, non-HTML
+// type. ErrTypeMismatch is returned when they conflict. Content of no
+// recognized type is never reported as a mismatch.
+func (f *File) ValidateType() (string, error) {
+	detected := DetectContentType(f.Content)
+	declared := strings.ToLower(strings.TrimSpace(strings.SplitN(f.Type, ";", 2)[0]))
+	if declared == "image/jpg" {
+		declared = TypeJPEG
+	}
+	if detected == "" || declared == detected {
+		return detected, nil
+	} else if isStrictType(declared) || isStrictType(detected) {
+		return detected, ErrTypeMismatch
+	}
+	return detected, nil
+}
+
+// skipPrologue skips whitespace, XML declarations, comments and doctypes at
+// the start of text, returning what follows, the lower-cased doctype, whether
+// an XML declaration was found and whether anything was skipped. Unterminated
+// markup consumes the rest of text.
+func skipPrologue(text []byte) (rest []byte, doctype string, xmlDecl, markup bool) {
+	for {
+		text = bytes.TrimLeftFunc(text, unicode.IsSpace)
+		var end []byte
+		switch {
+		case hasPrefixFold(text, "<!--"):
+			end = []byte("-->")
+		case hasPrefixFold(text, "<!doctype"):
+			end = []byte(">")
+			if n := bytes.Index(text, end); n >= 0 {
+				doctype = strings.ToLower(strings.TrimSpace(string(text[len("<!doctype"):n])))
+			}
+		case hasPrefixFold(text, "<?xml"):
+			end = []byte("?>")
+			xmlDecl = true
+		default:
+			return text, doctype, xmlDecl, markup
+		}
+		markup = true
+		n := bytes.Index(text, end)
+		if n < 0 {
+			return nil, doctype, xmlDecl, markup
+		}
+		text = text[n+len(end):]
+	}
+}
+
+// hasPrefixFold reports whether text begins with prefix, ignoring case
+func hasPrefixFold(text []byte, prefix string) bool {
+	return len(text) >= len(prefix) && bytes.EqualFold(text[:len(prefix)], []byte(prefix))
+}
+
+func isASCIILetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// isStrictType reports whether t may only be declared for content of type t
+func isStrictType(t string) bool {
+	switch t {
+	case TypePNG, TypeJPEG, TypeGIF, TypeWebP, TypeSVG, TypeHTML:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package inscription
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+)
+
+var ErrNotFound = errors.New("no inscription envelope found")
+
+// byteReader is a reader that can be read from one byte at a time
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// DecodeReader reads a script from r up to the end of its first inscription
+// envelope, copying the content to w while hashing it. Content is never held
+// in memory: File.Content, ScriptPrefix and ScriptSuffix are left empty. When
+// w is nil the content is skipped and only its type, size and hash are
+// returned. ErrNotFound is returned if r holds no envelope. A body followed
+// by an opcode other than OP_ENDIF returns ErrUnterminated: Decode skips such
+// an envelope, but its content has already been copied to w.
+//
+// When r implements io.ByteReader, as a bufio.Reader does, nothing past the
+// envelope is read, so r may be positioned within a larger stream. Any other
+// reader is buffered and may be read past the envelope.
+func DecodeReader(r io.Reader, w io.Writer) (*Inscription, error) {
+	br, ok := r.(byteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	// No opcodes precede the start of the script
+	prev := [2]byte{script.OpINVALIDOPCODE, script.OpINVALIDOPCODE}
+	for {
+		op, length, err := readOpHeader(br)
+		if err == io.EOF {
+			return nil, ErrNotFound
+		} else if err != nil {
+			return nil, err
+		}
+		if op == script.OpDATA3 && prev[0] == script.Op0 && prev[1] == script.OpIF {
+			if data, err := readData(br, length); err != nil {
+				return nil, err
+			} else if bytes.Equal(data, []byte("ord")) {
+				return decodeEnvelopeReader(br, w)
+			}
+		} else if _, err = io.CopyN(io.Discard, br, int64(length)); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		prev[0], prev[1] = prev[1], op
+	}
+}
+
+// decodeEnvelopeReader decodes the envelope fields following the "ord" marker
+func decodeEnvelopeReader(br byteReader, w io.Writer) (*Inscription, error) {
+	insc := &Inscription{}
+	for {
+		op, length, err := readOpHeader(br)
+		if err != nil {
+			return insc, unexpectedEOF(err)
+		} else if op > script.Op16 {
+			return insc, nil
+		}
+		tag := &script.ScriptChunk{Op: op}
+		if tag.Data, err = readData(br, length); err != nil {
+			return insc, err
+		}
+
+		field, ok := fieldTag(tag)
+		if ok && field == FieldContent {
+			// The tag is followed directly by the body's pushes
+			break
+		}
+		op, length, err = readOpHeader(br)
+		if err != nil {
+			return insc, unexpectedEOF(err)
+		} else if op > script.Op16 {
+			return insc, nil
+		}
+		if data, err := readData(br, length); err != nil {
+			return insc, err
+		} else if ok {
+			_ = insc.setField(field, data)
+		}
+	}
+
+	hash := sha256.New()
+	dst := io.Writer(hash)
+	if w != nil {
+		dst = io.MultiWriter(hash, w)
+	}
+	var size int64
+	for {
+		op, length, err := readOpHeader(br)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return insc, err
+		} else if op == script.OpENDIF {
+			break
+		} else if op > script.OpPUSHDATA4 {
+			return insc, ErrUnterminated
+		}
+		n, err := io.CopyN(dst, br, int64(length))
+		size += n
+		if err != nil {
+			return insc, unexpectedEOF(err)
+		}
+	}
+	insc.File.Size = uint32(size) //nolint:gosec // G115: safe conversion
+	insc.File.Hash = hash.Sum(nil)
+	return insc, nil
+}
+
+// readOpHeader reads an opcode and, for pushes, the length of the data that
+// follows it
+func readOpHeader(br byteReader) (byte, uint32, error) {
+	op, err := br.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	switch {
+	case op > script.Op0 && op < script.OpPUSHDATA1:
+		return op, uint32(op), nil
+	case op == script.OpPUSHDATA1:
+		length, err := br.ReadByte()
+		return op, uint32(length), unexpectedEOF(err)
+	case op == script.OpPUSHDATA2:
+		var length [2]byte
+		_, err = io.ReadFull(br, length[:])
+		return op, uint32(binary.LittleEndian.Uint16(length[:])), unexpectedEOF(err)
+	case op == script.OpPUSHDATA4:
+		var length [4]byte
+		_, err = io.ReadFull(br, length[:])
+		return op, binary.LittleEndian.Uint32(length[:]), unexpectedEOF(err)
+	}
+	return op, 0, nil
+}
+
+// readData reads a push of length bytes. Memory grows with the data actually
+// read, so a bogus length cannot force a large allocation.
+func readData(br byteReader, length uint32) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(br, int64(length)))
+	if err != nil {
+		return nil, err
+	} else if len(data) < int(length) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return data, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
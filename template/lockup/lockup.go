@@ -12,6 +12,8 @@ import (
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
 	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
 )
 
 type Lock struct {
@@ -19,7 +21,7 @@ type Lock struct {
 	Until   uint32          `json:"until"`
 }
 
-func Decode(scr *script.Script, mainnet bool) *Lock {
+func Decode(scr *script.Script, network ...lib.Network) *Lock {
 	lockPrefixIndex := bytes.Index(*scr, LockPrefix)
 	if lockPrefixIndex > -1 && bytes.Contains((*scr)[lockPrefixIndex:], LockSuffix) {
 		lock := &Lock{}
@@ -28,7 +30,7 @@ func Decode(scr *script.Script, mainnet bool) *Lock {
 			log.Println(err)
 		} else if len(op.Data) != 20 {
 			return nil
-		} else if lock.Address, err = script.NewAddressFromPublicKeyHash(op.Data, mainnet); err != nil {
+		} else if lock.Address, err = script.NewAddressFromPublicKeyHash(op.Data, lib.IsMainnet(network...)); err != nil {
 			return nil
 		}
 		if op, err := scr.ReadOp(&pos); err != nil {
@@ -13,6 +13,8 @@ import (
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
 )
 
 // TestLockPrefixSuffix verifies that the LockPrefix and LockSuffix constants are set
@@ -87,7 +89,7 @@ func TestLockDecode(t *testing.T) {
 	require.NotNil(t, lockScript)
 
 	// Now decode the script
-	decodedLock := Decode(lockScript, true)
+	decodedLock := Decode(lockScript, lib.Mainnet)
 	require.NotNil(t, decodedLock)
 
 	// Verify the decoded values match what we put in
@@ -232,7 +234,7 @@ func TestLockDecodeInvalid(t *testing.T) {
 	_ = invalidScript.AppendOpcodes(script.OpRETURN)
 
 	// Try to decode
-	decodedLock := Decode(invalidScript, true)
+	decodedLock := Decode(invalidScript, lib.Mainnet)
 	require.Nil(t, decodedLock)
 
 	// Create a script with valid prefix but invalid content
@@ -240,7 +242,7 @@ func TestLockDecodeInvalid(t *testing.T) {
 	_ = invalidWithPrefix.AppendOpcodes(script.OpRETURN)
 
 	// Try to decode
-	decodedLock = Decode(invalidWithPrefix, true)
+	decodedLock = Decode(invalidWithPrefix, lib.Mainnet)
 	require.Nil(t, decodedLock)
 }
 
@@ -253,6 +255,6 @@ func TestLockDecodeWithInvalidPKH(t *testing.T) {
 	invalidPKHScript = script.NewFromBytes(append(*invalidPKHScript, LockSuffix...))
 
 	// Try to decode
-	decodedLock := Decode(invalidPKHScript, true)
+	decodedLock := Decode(invalidPKHScript, lib.Mainnet)
 	require.Nil(t, decodedLock)
 }
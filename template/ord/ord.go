@@ -0,0 +1,139 @@
+// Package ord combines an Ordinal inscription with the locking script of any
+// template in this repository.
+//
+// The inscription envelope is placed in front of the locking script, which
+// remains spendable as usual. On decode, the locking script following (or,
+// failing that, preceding) the envelope is identified as a P2PKH, cosign,
+// lockup, hash puzzle or OrdLock script and returned as its typed value.
+package ord
+
+import (
+	"errors"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
+	"github.com/bsv-blockchain/go-script-templates/template/cosign"
+	"github.com/bsv-blockchain/go-script-templates/template/hashpuzzle"
+	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+	"github.com/bsv-blockchain/go-script-templates/template/lockup"
+	"github.com/bsv-blockchain/go-script-templates/template/ordlock"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
+)
+
+var ErrNoInscription = errors.New("inscription not supplied")
+
+// Template identifies the locking script holding an inscription
+type Template string
+
+const (
+	TemplateUnknown    Template = ""
+	TemplateP2PKH      Template = "p2pkh"
+	TemplateCosign     Template = "cosign"
+	TemplateLockup     Template = "lockup"
+	TemplateHashPuzzle Template = "hashpuzzle"
+	TemplateOrdLock    Template = "ordlock"
+)
+
+// Ord is an inscription held by a locking script. Exactly one of the template
+// fields is set, matching Template.
+type Ord struct {
+	Inscription *inscription.Inscription `json:"inscription"`
+	Template    Template                 `json:"template,omitempty"`
+	Address     *script.Address          `json:"address,omitempty"`
+	Cosign      *cosign.Cosign           `json:"cosign,omitempty"`
+	Lockup      *lockup.Lock             `json:"lockup,omitempty"`
+	HashPuzzle  *hashpuzzle.HashPuzzle   `json:"hashpuzzle,omitempty"`
+	OrdLock     *ordlock.OrdLock         `json:"ordlock,omitempty"`
+}
+
+// Decode returns the first inscription in s along with the template of the
+// script holding it, or nil if s has no inscription. An inscription held by
+// an unrecognized script is returned with TemplateUnknown. Addresses are
+// encoded for the given network, defaulting to mainnet.
+func Decode(s *script.Script, network ...lib.Network) *Ord {
+	if s == nil {
+		return nil
+	}
+	insc := inscription.Decode(s)
+	if insc == nil {
+		return nil
+	}
+
+	o := &Ord{
+		Inscription: insc,
+	}
+	if o.OrdLock = ordlock.Decode(s, network...); o.OrdLock != nil {
+		o.Template = TemplateOrdLock
+		return o
+	}
+	for _, lockingScript := range [][]byte{insc.ScriptSuffix, insc.ScriptPrefix} {
+		if len(lockingScript) > 0 && o.decodeTemplate(script.NewFromBytes(lockingScript), network...) {
+			return o
+		}
+	}
+	return o
+}
+
+// decodeTemplate identifies the template of s. Data following an OP_RETURN,
+// such as a Bitcom tail, is ignored for templates that must match exactly.
+func (o *Ord) decodeTemplate(s *script.Script, network ...lib.Network) bool {
+	if o.Cosign = cosign.Decode(s, network...); o.Cosign != nil {
+		o.Template = TemplateCosign
+	} else if o.Lockup = lockup.Decode(s, network...); o.Lockup != nil {
+		o.Template = TemplateLockup
+	} else if o.HashPuzzle = hashpuzzle.Decode(trimData(s)); o.HashPuzzle != nil {
+		o.Template = TemplateHashPuzzle
+	} else if o.Address = p2pkh.Decode(trimData(s), network...); o.Address != nil {
+		o.Template = TemplateP2PKH
+	}
+	return o.Template != TemplateUnknown
+}
+
+// trimData returns s up to its first OP_RETURN
+func trimData(s *script.Script) *script.Script {
+	for pos := 0; pos < len(*s); {
+		start := pos
+		if op, err := s.ReadOp(&pos); err != nil {
+			break
+		} else if op.Op == script.OpRETURN {
+			return script.NewFromBytes((*s)[:start])
+		}
+	}
+	return s
+}
+
+// Owner returns the address controlling the inscription, or nil if the
+// template has none, as with a hash puzzle
+func (o *Ord) Owner() *script.Address {
+	switch o.Template {
+	case TemplateP2PKH:
+		return o.Address
+	case TemplateCosign:
+		if address, err := script.NewAddressFromString(o.Cosign.Address); err == nil {
+			return address
+		}
+	case TemplateLockup:
+		return o.Lockup.Address
+	case TemplateOrdLock:
+		return o.OrdLock.Seller
+	}
+	return nil
+}
+
+// Lock places the inscription in front of lockingScript, which may be built
+// with any template. Any script decoded before or after the envelope is
+// dropped, so the previous owner does not remain. The Inscription itself is
+// not modified.
+func (o *Ord) Lock(lockingScript *script.Script) (*script.Script, error) {
+	if o.Inscription == nil {
+		return nil, ErrNoInscription
+	}
+	insc := *o.Inscription
+	insc.ScriptPrefix = nil
+	insc.ScriptSuffix = nil
+	if lockingScript != nil {
+		insc.ScriptSuffix = *lockingScript
+	}
+	return insc.Lock()
+}
@@ -0,0 +1,163 @@
+package ord
+
+import (
+	"encoding/hex"
+	"testing"
+
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
+	"github.com/bsv-blockchain/go-script-templates/template/cosign"
+	"github.com/bsv-blockchain/go-script-templates/template/hashpuzzle"
+	"github.com/bsv-blockchain/go-script-templates/template/inscription"
+	"github.com/bsv-blockchain/go-script-templates/template/lockup"
+	"github.com/bsv-blockchain/go-script-templates/template/ordlock"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
+)
+
+func newOrd() *Ord {
+	return &Ord{
+		Inscription: &inscription.Inscription{
+			File: inscription.File{
+				Type:    "text/plain",
+				Content: []byte("Hello, Ord!"),
+			},
+		},
+	}
+}
+
+// TestLockDecodeTemplates tests that the template holding an inscription is identified
+func TestLockDecodeTemplates(t *testing.T) {
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+	approver, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+
+	p2pkhScript, err := p2pkh.Lock(address)
+	require.NoError(t, err)
+	cosignScript, err := cosign.Lock(address, approver.PubKey())
+	require.NoError(t, err)
+	lockupScript := lockup.Lock{Address: address, Until: 900000}.Lock()
+	_, hash, err := hashpuzzle.GenerateSecretPair()
+	require.NoError(t, err)
+	puzzleScript, err := hashpuzzle.Lock(hash)
+	require.NoError(t, err)
+	listingScript, err := (&ordlock.OrdLock{Seller: address, Price: 1000}).Lock(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		template      Template
+		lockingScript *script.Script
+		owner         *script.Address
+	}{
+		{TemplateP2PKH, p2pkhScript, address},
+		{TemplateCosign, cosignScript, address},
+		{TemplateLockup, lockupScript, address},
+		{TemplateHashPuzzle, puzzleScript, nil},
+		{TemplateOrdLock, listingScript, address},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.template), func(t *testing.T) {
+			o := newOrd()
+			s, err := o.Lock(tt.lockingScript)
+			require.NoError(t, err)
+			require.Empty(t, o.Inscription.ScriptSuffix, "Lock should not modify the inscription")
+
+			decoded := Decode(s)
+			require.NotNil(t, decoded)
+			require.Equal(t, tt.template, decoded.Template)
+			require.Equal(t, "Hello, Ord!", string(decoded.Inscription.File.Content))
+			if tt.owner == nil {
+				require.Nil(t, decoded.Owner())
+			} else {
+				require.Equal(t, tt.owner.AddressString, decoded.Owner().AddressString)
+			}
+		})
+	}
+
+	o := Decode(mustLock(t, lockupScript))
+	require.Equal(t, uint32(900000), o.Lockup.Until)
+	o = Decode(mustLock(t, cosignScript))
+	require.Equal(t, hex.EncodeToString(approver.PubKey().Compressed()), o.Cosign.Cosigner)
+	o = Decode(mustLock(t, puzzleScript))
+	require.Equal(t, hash, o.HashPuzzle.Hash)
+	o = Decode(mustLock(t, listingScript))
+	require.Equal(t, uint64(1000), o.OrdLock.Price)
+}
+
+func mustLock(t *testing.T, lockingScript *script.Script) *script.Script {
+	s, err := newOrd().Lock(lockingScript)
+	require.NoError(t, err)
+	return s
+}
+
+// TestDecodeVariants tests scripts with data tails, prefixed owners and unknown owners
+func TestDecodeVariants(t *testing.T) {
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), false)
+	require.NoError(t, err)
+	p2pkhScript, err := p2pkh.Lock(address)
+	require.NoError(t, err)
+
+	// P2PKH followed by a Bitcom tail
+	withTail := script.NewFromBytes(append([]byte{}, *p2pkhScript...))
+	_ = withTail.AppendOpcodes(script.OpRETURN)
+	_ = withTail.AppendPushData([]byte("1PuQa7K62MiKCtssSLKy1kh56WWU7MtUR5"))
+	o := Decode(mustLock(t, withTail), lib.Testnet)
+	require.NotNil(t, o)
+	require.Equal(t, TemplateP2PKH, o.Template)
+	require.Equal(t, address.AddressString, o.Owner().AddressString)
+
+	// P2PKH in front of the envelope
+	insc := newOrd().Inscription
+	insc.ScriptPrefix = *p2pkhScript
+	s, err := insc.Lock()
+	require.NoError(t, err)
+	o = Decode(s, lib.Testnet)
+	require.Equal(t, TemplateP2PKH, o.Template)
+	require.Equal(t, address.AddressString, o.Address.AddressString)
+
+	// Re-locking a prefixed inscription drops the previous owner
+	newKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	newAddress, err := script.NewAddressFromPublicKey(newKey.PubKey(), false)
+	require.NoError(t, err)
+	newP2pkh, err := p2pkh.Lock(newAddress)
+	require.NoError(t, err)
+	relocked, err := o.Lock(newP2pkh)
+	require.NoError(t, err)
+	o = Decode(relocked, lib.Testnet)
+	require.Empty(t, o.Inscription.ScriptPrefix)
+	require.Equal(t, []byte(*newP2pkh), o.Inscription.ScriptSuffix)
+	require.Equal(t, newAddress.AddressString, o.Owner().AddressString)
+
+	// Unknown locking script
+	o = Decode(mustLock(t, script.NewFromBytes([]byte{script.OpTRUE})))
+	require.NotNil(t, o)
+	require.Equal(t, TemplateUnknown, o.Template)
+	require.Nil(t, o.Owner())
+
+	// A delegate envelope without a body
+	delegated := &script.Script{}
+	_ = delegated.AppendOpcodes(script.Op0, script.OpIF)
+	_ = delegated.AppendPushData([]byte("ord"))
+	_ = delegated.AppendOpcodes(script.Op11)
+	_ = delegated.AppendPushData(make([]byte, 32))
+	_ = delegated.AppendOpcodes(script.OpENDIF)
+	o = Decode(script.NewFromBytes(append(*delegated, *p2pkhScript...)), lib.Testnet)
+	require.NotNil(t, o)
+	require.NotNil(t, o.Inscription.Delegate)
+	require.Equal(t, TemplateP2PKH, o.Template)
+	require.Equal(t, address.AddressString, o.Owner().AddressString)
+
+	require.Nil(t, Decode(p2pkhScript))
+	require.Nil(t, Decode(nil))
+
+	_, err = (&Ord{}).Lock(p2pkhScript)
+	require.ErrorIs(t, err, ErrNoInscription)
+}
@@ -2,20 +2,45 @@ package ordlock
 
 import (
 	"bytes"
+	"errors"
+	"math"
+	"math/big"
 
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
 	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction"
+	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
+	"github.com/bsv-blockchain/go-script-templates/template/bsv21"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
+)
+
+var (
+	ErrBadPublicKeyHash = errors.New("invalid public key hash")
+	ErrNoPrivateKey     = errors.New("private key not supplied")
+	ErrNoOrdinalOutput  = errors.New("purchase transaction has no ordinal output")
+	ErrNotOrdLock       = errors.New("utxo is not an ordlock listing")
 )
 
 type OrdLock struct {
-	Seller   *script.Address `json:"seller"`
-	Price    uint64          `json:"price"`
-	PricePer float64         `json:"pricePer"`
-	PayOut   []byte          `json:"payout"`
+	Seller *script.Address `json:"seller"`
+	Price  uint64          `json:"price"`
+	// PricePer is the price of one whole listed token. Transfer inscriptions
+	// do not carry the token's decimals, so it is only set when the listing's
+	// inscription declares them; otherwise it is 0 and PricePerToken must be
+	// called with the decimals from the token's deployment.
+	PricePer float64      `json:"pricePer"`
+	PayOut   []byte       `json:"payout"`
+	Token    *bsv21.Bsv21 `json:"token,omitempty"` // BSV-21 tokens being listed, if any
 }
 
-func Decode(scr *script.Script) *OrdLock {
-	if sCryptPrefixIndex := bytes.Index(*scr, OrdLockPrefix); sCryptPrefixIndex == -1 {
+// Decode returns the OrdLock listing in scr, or nil. The seller address is
+// encoded for the given network, defaulting to mainnet.
+func Decode(scr *script.Script, network ...lib.Network) *OrdLock {
+	if scr == nil {
+		return nil
+	} else if sCryptPrefixIndex := bytes.Index(*scr, OrdLockPrefix); sCryptPrefixIndex == -1 {
 		return nil
 	} else if ordLockSuffixIndex := bytes.Index(*scr, OrdLockSuffix); ordLockSuffixIndex == -1 {
 		return nil
@@ -31,10 +56,205 @@ func Decode(scr *script.Script) *OrdLock {
 			Price:  payOutput.Satoshis,
 			PayOut: payOutput.Bytes(),
 		}
-		if ordLock.Seller, err = script.NewAddressFromPublicKeyHash(ordLockOps[0].Data, true); err != nil {
+		if ordLock.Seller, err = script.NewAddressFromPublicKeyHash(ordLockOps[0].Data, lib.IsMainnet(network...)); err != nil {
 			return nil
 		}
+		if ordLock.Token = bsv21.Decode(scr); ordLock.Token != nil && ordLock.Token.Decimals != nil {
+			ordLock.PricePer = ordLock.PricePerToken(*ordLock.Token.Decimals)
+		}
 
 		return ordLock
 	}
 }
+
+// PricePerToken returns the price in satoshis of one whole listed token, for
+// a token with the given decimals as set by its deployment. It returns 0 if
+// the listing holds no tokens.
+func (o *OrdLock) PricePerToken(decimals uint8) float64 {
+	if o.Token == nil || o.Token.Amt == nil || o.Token.Amt.Sign() == 0 {
+		return 0
+	}
+	amt := new(big.Float).SetInt(o.Token.Amt)
+	amt.Quo(amt, big.NewFloat(math.Pow10(int(decimals))))
+	pricePer, _ := new(big.Float).Quo(new(big.Float).SetUint64(o.Price), amt).Float64()
+	return pricePer
+}
+
+// Lock builds the listing script: the OrdLock contract parameterized with the
+// seller's public key hash (who may cancel) and the serialized payout output.
+// When PayOut is empty, a P2PKH output paying Price to the seller is used.
+// If ordinalLockingScript is not nil, it is placed in front of the contract so
+// the listing can carry the ordinal's inscription envelope.
+func (o *OrdLock) Lock(ordinalLockingScript *script.Script) (*script.Script, error) {
+	if o.Seller == nil || len(o.Seller.PublicKeyHash) != 20 {
+		return nil, ErrBadPublicKeyHash
+	}
+	payOut := o.PayOut
+	if len(payOut) == 0 {
+		payScript, err := p2pkh.Lock(o.Seller)
+		if err != nil {
+			return nil, err
+		}
+		payOut = (&transaction.TransactionOutput{
+			Satoshis:      o.Price,
+			LockingScript: payScript,
+		}).Bytes()
+	}
+
+	s := &script.Script{}
+	if ordinalLockingScript != nil {
+		s = script.NewFromBytes(bytes.Clone(*ordinalLockingScript))
+	}
+	*s = append(*s, OrdLockPrefix...)
+	if err := s.AppendPushData(o.Seller.PublicKeyHash); err != nil {
+		return nil, err
+	} else if err = s.AppendPushData(payOut); err != nil {
+		return nil, err
+	}
+	return script.NewFromBytes(append(*s, OrdLockSuffix...)), nil
+}
+
+// BuildPurchaseTx builds an unsigned transaction buying the listing held by
+// listingUTXO. Outputs are laid out in the order the contract checks: the
+// ordinal to buyerAddress, the listing's payout, the optional marketFee output
+// and finally change to changeAddress. paymentUTXOs fund the purchase and must
+// carry their own unlocking templates. Call Fee and Sign before broadcasting.
+func BuildPurchaseTx(listingUTXO *transaction.UTXO, buyerAddress *script.Address, paymentUTXOs []*transaction.UTXO, changeAddress *script.Address, marketFee *transaction.TransactionOutput) (*transaction.Transaction, error) {
+	if listingUTXO == nil {
+		return nil, ErrNotOrdLock
+	} else if buyerAddress == nil {
+		return nil, p2pkh.ErrBadPublicKeyHash
+	}
+	listing := Decode(listingUTXO.LockingScript)
+	if listing == nil {
+		return nil, ErrNotOrdLock
+	}
+	payOutput := &transaction.TransactionOutput{}
+	if _, err := payOutput.ReadFrom(bytes.NewReader(listing.PayOut)); err != nil {
+		return nil, err
+	}
+	buyerScript, err := p2pkh.Lock(buyerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := transaction.NewTransaction()
+	if err = tx.AddInputsFromUTXOs(&transaction.UTXO{
+		TxID:                    listingUTXO.TxID,
+		Vout:                    listingUTXO.Vout,
+		LockingScript:           listingUTXO.LockingScript,
+		Satoshis:                listingUTXO.Satoshis,
+		UnlockingScriptTemplate: Purchase(),
+	}); err != nil {
+		return nil, err
+	} else if err = tx.AddInputsFromUTXOs(paymentUTXOs...); err != nil {
+		return nil, err
+	}
+
+	tx.AddOutput(&transaction.TransactionOutput{
+		LockingScript: buyerScript,
+		Satoshis:      listingUTXO.Satoshis,
+	})
+	tx.AddOutput(payOutput)
+	if marketFee != nil {
+		tx.AddOutput(marketFee)
+	}
+	if changeAddress != nil {
+		change := &transaction.TransactionOutput{
+			Change: true,
+		}
+		if change.LockingScript, err = p2pkh.Lock(changeAddress); err != nil {
+			return nil, err
+		}
+		tx.AddOutput(change)
+	}
+
+	return tx, nil
+}
+
+// PurchaseUnlocker spends a listing by paying the seller. The contract verifies
+// through the sighash preimage that output 0 receives the ordinal and output 1
+// is the listing's payout; any further outputs are passed as trailing outputs.
+type PurchaseUnlocker struct{}
+
+// Purchase returns an unlocking template for buying a listing
+func Purchase() *PurchaseUnlocker {
+	return &PurchaseUnlocker{}
+}
+
+func (p *PurchaseUnlocker) Sign(tx *transaction.Transaction, inputIndex uint32) (*script.Script, error) {
+	if len(tx.Outputs) == 0 {
+		return nil, ErrNoOrdinalOutput
+	} else if tx.Inputs[inputIndex].SourceTxOutput() == nil {
+		return nil, transaction.ErrEmptyPreviousTx
+	}
+
+	s := &script.Script{}
+	if err := s.AppendPushData(tx.Outputs[0].Bytes()); err != nil {
+		return nil, err
+	}
+	if len(tx.Outputs) > 2 {
+		trailingOutputs := []byte{}
+		for _, output := range tx.Outputs[2:] {
+			trailingOutputs = append(trailingOutputs, output.Bytes()...)
+		}
+		if err := s.AppendPushData(trailingOutputs); err != nil {
+			return nil, err
+		}
+	} else {
+		_ = s.AppendOpcodes(script.Op0)
+	}
+	if preimage, err := tx.CalcInputPreimage(inputIndex, sighash.All|sighash.AnyOneCanPayForkID); err != nil {
+		return nil, err
+	} else if err = s.AppendPushData(preimage); err != nil {
+		return nil, err
+	}
+	_ = s.AppendOpcodes(script.Op0)
+	return s, nil
+}
+
+func (p *PurchaseUnlocker) EstimateLength(tx *transaction.Transaction, inputIndex uint32) uint32 {
+	if u, err := p.Sign(tx, inputIndex); err != nil {
+		return 0
+	} else {
+		return uint32(len(*u)) //nolint:gosec // G115: len() always returns non-negative
+	}
+}
+
+// CancelUnlocker spends a listing back to the seller with a P2PKH-style
+// signature from the seller's key.
+type CancelUnlocker struct {
+	PrivateKey  *ec.PrivateKey
+	SigHashFlag *sighash.Flag
+}
+
+// Cancel returns an unlocking template for the seller to cancel a listing
+func Cancel(key *ec.PrivateKey, sigHashFlag *sighash.Flag) (*CancelUnlocker, error) {
+	if key == nil {
+		return nil, ErrNoPrivateKey
+	}
+	if sigHashFlag == nil {
+		shf := sighash.AllForkID
+		sigHashFlag = &shf
+	}
+	return &CancelUnlocker{
+		PrivateKey:  key,
+		SigHashFlag: sigHashFlag,
+	}, nil
+}
+
+func (c *CancelUnlocker) Sign(tx *transaction.Transaction, inputIndex uint32) (*script.Script, error) {
+	s, err := (&p2pkh.P2PKH{
+		PrivateKey:  c.PrivateKey,
+		SigHashFlag: c.SigHashFlag,
+	}).Sign(tx, inputIndex)
+	if err != nil {
+		return nil, err
+	}
+	_ = s.AppendOpcodes(script.Op1)
+	return s, nil
+}
+
+func (c *CancelUnlocker) EstimateLength(_ *transaction.Transaction, inputIndex uint32) uint32 {
+	return 107
+}
@@ -1,14 +1,23 @@
 package ordlock
 
 import (
+	"bytes"
 	"encoding/hex"
+	"math/big"
 	"os"
 	"strings"
 	"testing"
 
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
 	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/bsv-blockchain/go-sdk/script/interpreter"
 	"github.com/bsv-blockchain/go-sdk/transaction"
+	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
+	"github.com/bsv-blockchain/go-script-templates/template/bsv21"
+	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
 )
 
 func TestOrdLock(t *testing.T) {
@@ -223,3 +232,319 @@ func TestDecodeWithTestVector(t *testing.T) {
 		}
 	}
 }
+
+// buildListing creates a source transaction holding an OrdLock listing at output 0
+func buildListing(t *testing.T, seller *ec.PrivateKey, price uint64) (*OrdLock, *transaction.Transaction) {
+	t.Helper()
+	sellerAddr, err := script.NewAddressFromPublicKey(seller.PubKey(), true)
+	require.NoError(t, err)
+
+	ordLock := &OrdLock{
+		Seller: sellerAddr,
+		Price:  price,
+	}
+	lockingScript, err := ordLock.Lock(nil)
+	require.NoError(t, err)
+
+	sourceTx := transaction.NewTransaction()
+	sourceTx.AddOutput(&transaction.TransactionOutput{
+		Satoshis:      1,
+		LockingScript: lockingScript,
+	})
+	return ordLock, sourceTx
+}
+
+// TestOrdLockLockRoundTrip verifies that Lock produces a script Decode understands
+func TestOrdLockLockRoundTrip(t *testing.T) {
+	seller, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	ordLock, sourceTx := buildListing(t, seller, 5000)
+
+	decoded := Decode(sourceTx.Outputs[0].LockingScript)
+	require.NotNil(t, decoded)
+	require.Equal(t, ordLock.Seller.AddressString, decoded.Seller.AddressString)
+	require.Equal(t, uint64(5000), decoded.Price)
+
+	// The default payout pays the seller via P2PKH
+	payOutput := &transaction.TransactionOutput{}
+	_, err = payOutput.ReadFrom(bytes.NewReader(decoded.PayOut))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), payOutput.Satoshis)
+	require.True(t, payOutput.LockingScript.IsP2PKH())
+
+	// An explicit payout is preserved and an ordinal script is kept in front
+	inscriptionScript := script.NewFromBytes([]byte{script.Op0, script.OpIF, script.OpENDIF})
+	withPayOut := &OrdLock{
+		Seller: ordLock.Seller,
+		PayOut: decoded.PayOut,
+	}
+	lockingScript, err := withPayOut.Lock(inscriptionScript)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(*lockingScript, *inscriptionScript))
+	require.Equal(t, decoded.PayOut, Decode(lockingScript).PayOut)
+
+	// The ordinal script is copied, so appending to it leaves the listing intact
+	spare := make([]byte, len(*inscriptionScript), 1024)
+	copy(spare, *inscriptionScript)
+	ordinalScript := script.NewFromBytes(spare)
+	lockingScript, err = withPayOut.Lock(ordinalScript)
+	require.NoError(t, err)
+	listing := bytes.Clone(*lockingScript)
+	*ordinalScript = append(*ordinalScript, bytes.Repeat([]byte{script.OpTRUE}, 512)...)
+	require.Equal(t, listing, []byte(*lockingScript))
+
+	// A seller is required
+	_, err = (&OrdLock{Price: 1}).Lock(nil)
+	require.ErrorIs(t, err, ErrBadPublicKeyHash)
+}
+
+// TestOrdLockPurchase executes the purchase unlock against the contract
+func TestOrdLockPurchase(t *testing.T) {
+	seller, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	buyer, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	_, sourceTx := buildListing(t, seller, 5000)
+	listing := Decode(sourceTx.Outputs[0].LockingScript)
+	require.NotNil(t, listing)
+
+	buyerAddr, err := script.NewAddressFromPublicKey(buyer.PubKey(), true)
+	require.NoError(t, err)
+	buyerScript, err := p2pkh.Lock(buyerAddr)
+	require.NoError(t, err)
+
+	payOutput := &transaction.TransactionOutput{}
+	_, err = payOutput.ReadFrom(bytes.NewReader(listing.PayOut))
+	require.NoError(t, err)
+
+	tx := transaction.NewTransaction()
+	tx.AddInputFromTx(sourceTx, 0, Purchase())
+	tx.AddOutput(&transaction.TransactionOutput{Satoshis: 1, LockingScript: buyerScript})
+	tx.AddOutput(payOutput)
+	tx.AddOutput(&transaction.TransactionOutput{Satoshis: 1000, LockingScript: buyerScript})
+
+	unlockingScript, err := Purchase().Sign(tx, 0)
+	require.NoError(t, err)
+	tx.Inputs[0].UnlockingScript = unlockingScript
+	require.Equal(t, uint32(len(*unlockingScript)), Purchase().EstimateLength(tx, 0)) //nolint:gosec // test value
+
+	err = interpreter.NewEngine().Execute(
+		interpreter.WithTx(tx, 0, sourceTx.Outputs[0]),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	)
+	require.NoError(t, err)
+
+	// Paying the seller less than the listing price must fail
+	payOutput.Satoshis--
+	unlockingScript, err = Purchase().Sign(tx, 0)
+	require.NoError(t, err)
+	tx.Inputs[0].UnlockingScript = unlockingScript
+	err = interpreter.NewEngine().Execute(
+		interpreter.WithTx(tx, 0, sourceTx.Outputs[0]),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	)
+	require.Error(t, err)
+}
+
+// TestOrdLockCancel executes the seller's cancel unlock against the contract
+func TestOrdLockCancel(t *testing.T) {
+	seller, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	ordLock, sourceTx := buildListing(t, seller, 5000)
+
+	sellerScript, err := p2pkh.Lock(ordLock.Seller)
+	require.NoError(t, err)
+
+	unlocker, err := Cancel(seller, nil)
+	require.NoError(t, err)
+
+	tx := transaction.NewTransaction()
+	tx.AddInputFromTx(sourceTx, 0, unlocker)
+	tx.AddOutput(&transaction.TransactionOutput{Satoshis: 1, LockingScript: sellerScript})
+	require.NoError(t, tx.Sign())
+
+	err = interpreter.NewEngine().Execute(
+		interpreter.WithTx(tx, 0, sourceTx.Outputs[0]),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	)
+	require.NoError(t, err)
+
+	// Anyone other than the seller cannot cancel
+	other, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	tx.Inputs[0].UnlockingScriptTemplate, err = Cancel(other, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign())
+	err = interpreter.NewEngine().Execute(
+		interpreter.WithTx(tx, 0, sourceTx.Outputs[0]),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	)
+	require.Error(t, err)
+
+	_, err = Cancel(nil, nil)
+	require.ErrorIs(t, err, ErrNoPrivateKey)
+}
+
+// TestBuildPurchaseTx verifies the output layout and that every input validates
+func TestBuildPurchaseTx(t *testing.T) {
+	seller, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	buyer, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	_, sourceTx := buildListing(t, seller, 5000)
+
+	buyerAddr, err := script.NewAddressFromPublicKey(buyer.PubKey(), true)
+	require.NoError(t, err)
+	buyerScript, err := p2pkh.Lock(buyerAddr)
+	require.NoError(t, err)
+
+	// Fund the buyer
+	fundingTx := transaction.NewTransaction()
+	fundingTx.AddOutput(&transaction.TransactionOutput{Satoshis: 10000, LockingScript: buyerScript})
+	buyerUnlock, err := p2pkh.Unlock(buyer, nil)
+	require.NoError(t, err)
+
+	marketFee := &transaction.TransactionOutput{Satoshis: 100, LockingScript: buyerScript}
+	tx, err := BuildPurchaseTx(
+		&transaction.UTXO{
+			TxID:          sourceTx.TxID(),
+			Vout:          0,
+			LockingScript: sourceTx.Outputs[0].LockingScript,
+			Satoshis:      sourceTx.Outputs[0].Satoshis,
+		},
+		buyerAddr,
+		[]*transaction.UTXO{{
+			TxID:                    fundingTx.TxID(),
+			Vout:                    0,
+			LockingScript:           fundingTx.Outputs[0].LockingScript,
+			Satoshis:                10000,
+			UnlockingScriptTemplate: buyerUnlock,
+		}},
+		buyerAddr,
+		marketFee,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, tx.Inputs, 2)
+	require.Len(t, tx.Outputs, 4)
+	require.Equal(t, buyerScript.Bytes(), tx.Outputs[0].LockingScript.Bytes())
+	require.Equal(t, uint64(1), tx.Outputs[0].Satoshis)
+	require.Equal(t, Decode(sourceTx.Outputs[0].LockingScript).PayOut, tx.Outputs[1].Bytes())
+	require.Equal(t, marketFee, tx.Outputs[2])
+	require.True(t, tx.Outputs[3].Change)
+
+	require.NoError(t, tx.Fee(&feemodel.SatoshisPerKilobyte{Satoshis: 1}, transaction.ChangeDistributionEqual))
+	require.NoError(t, tx.Sign())
+
+	prevOutputs := []*transaction.TransactionOutput{sourceTx.Outputs[0], fundingTx.Outputs[0]}
+	for i, prevOutput := range prevOutputs {
+		err = interpreter.NewEngine().Execute(
+			interpreter.WithTx(tx, i, prevOutput),
+			interpreter.WithForkID(),
+			interpreter.WithAfterGenesis(),
+		)
+		require.NoError(t, err, "input %d should validate", i)
+	}
+
+	// A non-listing utxo is rejected
+	_, err = BuildPurchaseTx(
+		&transaction.UTXO{LockingScript: buyerScript, Satoshis: 1},
+		buyerAddr, nil, nil, nil,
+	)
+	require.ErrorIs(t, err, ErrNotOrdLock)
+	_, err = BuildPurchaseTx(&transaction.UTXO{Satoshis: 1}, buyerAddr, nil, nil, nil)
+	require.ErrorIs(t, err, ErrNotOrdLock)
+	_, err = BuildPurchaseTx(nil, buyerAddr, nil, nil, nil)
+	require.ErrorIs(t, err, ErrNotOrdLock)
+
+	// A buyer address is required
+	_, err = BuildPurchaseTx(&transaction.UTXO{
+		TxID:          sourceTx.TxID(),
+		LockingScript: sourceTx.Outputs[0].LockingScript,
+		Satoshis:      sourceTx.Outputs[0].Satoshis,
+	}, nil, nil, nil, nil)
+	require.ErrorIs(t, err, p2pkh.ErrBadPublicKeyHash)
+}
+
+// TestDecodeNetwork verifies the seller address follows the requested network
+func TestDecodeNetwork(t *testing.T) {
+	seller, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	_, sourceTx := buildListing(t, seller, 5000)
+	lockingScript := sourceTx.Outputs[0].LockingScript
+
+	testnetAddr, err := script.NewAddressFromPublicKey(seller.PubKey(), false)
+	require.NoError(t, err)
+
+	require.NotEqual(t, testnetAddr.AddressString, Decode(lockingScript).Seller.AddressString)
+	require.Equal(t, testnetAddr.AddressString, Decode(lockingScript, lib.Testnet).Seller.AddressString)
+}
+
+// TestDecodeTokenListing verifies the price per token of BSV-21 listings
+func TestDecodeTokenListing(t *testing.T) {
+	seller, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	sellerAddr, err := script.NewAddressFromPublicKey(seller.PubKey(), true)
+	require.NoError(t, err)
+
+	listingOf := func(token *bsv21.Bsv21, price uint64) *OrdLock {
+		inscScript, err := token.Lock(nil)
+		require.NoError(t, err)
+		lockingScript, err := (&OrdLock{Seller: sellerAddr, Price: price}).Lock(inscScript)
+		require.NoError(t, err)
+		listing := Decode(lockingScript)
+		require.NotNil(t, listing)
+		require.NotNil(t, listing.Token)
+		require.Equal(t, price, listing.Price)
+		return listing
+	}
+
+	tests := []struct {
+		name     string
+		amt      int64
+		decimals uint8
+		price    uint64
+		pricePer float64
+	}{
+		{"whole tokens", 50, 0, 1000, 20},
+		{"with decimals", 250, 2, 1000, 400},
+		{"zero amount", 0, 2, 1000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listing := listingOf(&bsv21.Bsv21{
+				Op:  string(bsv21.OpTransfer),
+				Id:  "abc_0",
+				Amt: big.NewInt(tt.amt),
+			}, tt.price)
+			require.Equal(t, "abc_0", listing.Token.Id)
+
+			// Transfers do not carry decimals, so they must be supplied
+			require.Nil(t, listing.Token.Decimals)
+			require.Zero(t, listing.PricePer)
+			require.InDelta(t, tt.pricePer, listing.PricePerToken(tt.decimals), 0.0001)
+		})
+	}
+
+	// A deploy+mint listing declares its decimals
+	decimals := uint8(2)
+	listing := listingOf(&bsv21.Bsv21{
+		Op:       string(bsv21.OpMint),
+		Amt:      big.NewInt(250),
+		Decimals: &decimals,
+	}, 1000)
+	require.InDelta(t, 400, listing.PricePer, 0.0001)
+
+	// Listings without a token have no price per token
+	lockingScript, err := (&OrdLock{Seller: sellerAddr, Price: 1000}).Lock(nil)
+	require.NoError(t, err)
+	listing = Decode(lockingScript)
+	require.Nil(t, listing.Token)
+	require.Zero(t, listing.PricePer)
+	require.Zero(t, listing.PricePerToken(2))
+}
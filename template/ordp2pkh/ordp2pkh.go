@@ -7,13 +7,21 @@
 package ordp2pkh
 
 import (
+	"errors"
+
 	"github.com/bsv-blockchain/go-sdk/script"
 
+	"github.com/bsv-blockchain/go-script-templates/lib"
 	"github.com/bsv-blockchain/go-script-templates/template/bitcom"
 	"github.com/bsv-blockchain/go-script-templates/template/inscription"
 	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
 )
 
+var (
+	ErrMissingMapApp  = errors.New("MAP metadata requires an app field")
+	ErrMissingMapType = errors.New("MAP metadata requires a type field")
+)
+
 // OrdP2PKH represents an inscription with a P2PKH locking script
 type OrdP2PKH struct {
 	Inscription *inscription.Inscription `json:"inscription"`
@@ -21,8 +29,9 @@ type OrdP2PKH struct {
 	Metadata    *bitcom.Map              `json:"metadata,omitempty"`
 }
 
-// Decode attempts to extract an OrdP2PKH from a script
-func Decode(s *script.Script) *OrdP2PKH {
+// Decode attempts to extract an OrdP2PKH from a script. The owner address is
+// encoded for the given network, defaulting to mainnet.
+func Decode(s *script.Script, network ...lib.Network) *OrdP2PKH {
 	if s == nil {
 		return nil
 	}
@@ -35,7 +44,7 @@ func Decode(s *script.Script) *OrdP2PKH {
 
 	// This is a valid inscription, so now we need to find the P2PKH address
 	// from either the prefix or suffix
-	addr := getAddressFromScript(inscr)
+	addr := getAddressFromScript(inscr, network...)
 	if addr == nil {
 		// No valid P2PKH address found
 		return nil
@@ -75,11 +84,11 @@ func getMetadataFromScript(s *script.Script) *bitcom.Map {
 }
 
 // getAddressFromScript extracts a P2PKH address from an inscription's prefix or suffix
-func getAddressFromScript(inscription *inscription.Inscription) *script.Address {
+func getAddressFromScript(inscription *inscription.Inscription, network ...lib.Network) *script.Address {
 	// Check prefix first
 	if len(inscription.ScriptPrefix) > 0 {
 		prefix := script.NewFromBytes(inscription.ScriptPrefix)
-		if address := p2pkh.Decode(prefix, true); address != nil {
+		if address := p2pkh.Decode(prefix, network...); address != nil {
 			return address
 		}
 	}
@@ -87,12 +96,12 @@ func getAddressFromScript(inscription *inscription.Inscription) *script.Address
 	// Then check suffix
 	if len(inscription.ScriptSuffix) > 0 {
 		suffix := script.NewFromBytes(inscription.ScriptSuffix)
-		if address := p2pkh.Decode(suffix, true); address != nil {
+		if address := p2pkh.Decode(suffix, network...); address != nil {
 			return address
 		}
 
 		// If direct decode failed, check if a P2PKH script is at the beginning of a larger suffix script
-		if addr := extractP2PKHFromScript(suffix); addr != nil {
+		if addr := extractP2PKHFromScript(suffix, network...); addr != nil {
 			return addr
 		}
 	}
@@ -100,7 +109,7 @@ func getAddressFromScript(inscription *inscription.Inscription) *script.Address
 	// Finally check prefix with extraction method as well
 	if len(inscription.ScriptPrefix) > 0 {
 		prefix := script.NewFromBytes(inscription.ScriptPrefix)
-		if addr := extractP2PKHFromScript(prefix); addr != nil {
+		if addr := extractP2PKHFromScript(prefix, network...); addr != nil {
 			return addr
 		}
 	}
@@ -110,7 +119,7 @@ func getAddressFromScript(inscription *inscription.Inscription) *script.Address
 
 // extractP2PKHFromScript attempts to extract a P2PKH address from a script
 // that might have additional data after the P2PKH part
-func extractP2PKHFromScript(s *script.Script) *script.Address {
+func extractP2PKHFromScript(s *script.Script, network ...lib.Network) *script.Address {
 	chunks, err := s.Chunks()
 	if err != nil || len(chunks) < 5 {
 		return nil
@@ -137,7 +146,7 @@ func extractP2PKHFromScript(s *script.Script) *script.Address {
 		*p2pkhScript = append(*p2pkhScript, script.OpEQUALVERIFY, script.OpCHECKSIG)
 
 		// Use the standard p2pkh.Decode with the cleaned script
-		return p2pkh.Decode(p2pkhScript, true)
+		return p2pkh.Decode(p2pkhScript, network...)
 	}
 
 	return nil
@@ -150,7 +159,9 @@ func (op *OrdP2PKH) Lock() (*script.Script, error) {
 }
 
 // LockWithMapMetadata creates a combined script that includes an inscription, a P2PKH locking script,
-// and optional MAP metadata.
+// and optional MAP metadata in a Bitcom tail. MAP keys are written in a fixed order, so the script
+// bytes are deterministic. SET metadata without app or type fields is rejected with ErrMissingMapApp
+// or ErrMissingMapType.
 // Returns the combined script and any error encountered.
 func (op *OrdP2PKH) LockWithMapMetadata(metadata *bitcom.Map) (*script.Script, error) {
 	// Create the P2PKH script
@@ -178,29 +189,25 @@ func (op *OrdP2PKH) LockWithMapMetadata(metadata *bitcom.Map) (*script.Script, e
 		return combinedScript, nil
 	}
 
-	// Validate MAP metadata - must have app and type fields
-	if _, hasApp := metadata.Data["app"]; !hasApp {
-		return combinedScript, nil // Return without MAP if app is missing
-	}
-
-	if _, hasType := metadata.Data["type"]; !hasType {
-		return combinedScript, nil // Return without MAP if type is missing
+	// Ordinal metadata set with MAP must identify its app and type
+	if metadata.Cmd == bitcom.MapCmdSet {
+		if _, hasApp := metadata.Data["app"]; !hasApp {
+			return nil, ErrMissingMapApp
+		} else if _, hasType := metadata.Data["type"]; !hasType {
+			return nil, ErrMissingMapType
+		}
 	}
-
-	// Create a standalone MAP script
-	mapScript := &script.Script{}
-	_ = mapScript.AppendOpcodes(script.OpFALSE, script.OpRETURN)
-	_ = mapScript.AppendPushDataString(bitcom.MapPrefix)
-	_ = mapScript.AppendPushDataString(string(metadata.Cmd))
-
-	// Add all key-value pairs
-	for key, value := range metadata.Data {
-		_ = mapScript.AppendPushDataString(key)
-		_ = mapScript.AppendPushDataString(value)
+	mapProto, err := metadata.Protocol()
+	if err != nil {
+		return nil, err
 	}
 
-	// Return the combined script with MAP script appended
-	return script.NewFromBytes(append(*combinedScript, *mapScript...)), nil
+	// Append MAP in a Bitcom tail after the P2PKH. A bare OP_RETURN leaves the
+	// OP_CHECKSIG result as the script's outcome.
+	return (&bitcom.Bitcom{
+		ScriptPrefix: *combinedScript,
+		Protocols:    []*bitcom.BitcomProtocol{mapProto},
+	}).Lock(), nil
 }
 
 // LockWithAddress is a convenience method that creates a new OrdP2PKH instance with the given address
@@ -6,11 +6,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/bsv-blockchain/go-sdk/chainhash"
 	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
 	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/bsv-blockchain/go-sdk/script/interpreter"
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bsv-blockchain/go-script-templates/lib"
 	"github.com/bsv-blockchain/go-script-templates/template/bitcom"
 	"github.com/bsv-blockchain/go-script-templates/template/inscription"
 	"github.com/bsv-blockchain/go-script-templates/template/p2pkh"
@@ -588,7 +591,7 @@ func getAddressFromScriptRobust(inscription *inscription.Inscription) *script.Ad
 			_ = p2pkhPart.AppendOpcodes(script.OpEQUALVERIFY, script.OpCHECKSIG)
 
 			// Check if this is a valid P2PKH script
-			return p2pkh.Decode(p2pkhPart, true)
+			return p2pkh.Decode(p2pkhPart, lib.Mainnet)
 		}
 	}
 
@@ -615,9 +618,116 @@ func getAddressFromScriptRobust(inscription *inscription.Inscription) *script.Ad
 			_ = p2pkhPart.AppendOpcodes(script.OpEQUALVERIFY, script.OpCHECKSIG)
 
 			// Check if this is a valid P2PKH script
-			return p2pkh.Decode(p2pkhPart, true)
+			return p2pkh.Decode(p2pkhPart, lib.Mainnet)
 		}
 	}
 
 	return nil
 }
+
+// TestLockWithMapMetadataDeterministic tests that MAP metadata is written in a fixed order
+// and that the output remains spendable
+func TestLockWithMapMetadataDeterministic(t *testing.T) {
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+	ordP2PKH := &OrdP2PKH{
+		Inscription: &inscription.Inscription{
+			File: inscription.File{Type: "text/plain", Content: []byte("metadata")},
+		},
+		Address: address,
+	}
+	metadata := &bitcom.Map{
+		Cmd: bitcom.MapCmdSet,
+		Data: map[string]string{
+			"name": "Test", "type": "ord", "zeta": "z", "app": "test", "alpha": "a", "creator": "me",
+		},
+	}
+
+	first, err := ordP2PKH.LockWithMapMetadata(metadata)
+	require.NoError(t, err)
+	for range 20 {
+		again, err := ordP2PKH.LockWithMapMetadata(metadata)
+		require.NoError(t, err)
+		require.Equal(t, *first, *again)
+	}
+
+	bc := bitcom.Decode(first)
+	require.NotNil(t, bc)
+	require.Len(t, bc.Protocols, 1)
+	chunks, err := script.NewFromBytes(bc.Protocols[0].Script).Chunks()
+	require.NoError(t, err)
+	var pushes []string
+	for _, chunk := range chunks {
+		pushes = append(pushes, string(chunk.Data))
+	}
+	require.Equal(t, []string{"SET", "app", "test", "type", "ord", "alpha", "a", "creator", "me", "name", "Test", "zeta", "z"}, pushes)
+
+	decoded := Decode(first)
+	require.NotNil(t, decoded)
+	require.Equal(t, address.AddressString, decoded.Address.AddressString)
+	require.Equal(t, metadata.Data, decoded.Metadata.Data)
+
+	// The output is spendable with a P2PKH signature despite the Bitcom tail
+	unlock, err := p2pkh.Unlock(key, nil)
+	require.NoError(t, err)
+	tx := transaction.NewTransaction()
+	require.NoError(t, tx.AddInputsFromUTXOs(&transaction.UTXO{
+		TxID:                    &chainhash.Hash{1},
+		LockingScript:           first,
+		Satoshis:                1,
+		UnlockingScriptTemplate: unlock,
+	}))
+	tx.AddOutput(&transaction.TransactionOutput{LockingScript: script.NewFromBytes([]byte{script.OpTRUE})})
+	require.NoError(t, tx.Sign())
+	require.NoError(t, interpreter.NewEngine().Execute(
+		interpreter.WithTx(tx, 0, tx.Inputs[0].SourceTxOutput()),
+		interpreter.WithForkID(),
+		interpreter.WithAfterGenesis(),
+	))
+}
+
+// TestLockWithMapMetadataCommands tests ADD and DEL emission and metadata errors
+func TestLockWithMapMetadataCommands(t *testing.T) {
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := script.NewAddressFromPublicKey(key.PubKey(), true)
+	require.NoError(t, err)
+	ordP2PKH := &OrdP2PKH{
+		Inscription: &inscription.Inscription{
+			File: inscription.File{Type: "text/plain", Content: []byte("metadata")},
+		},
+		Address: address,
+	}
+	pushes := func(s *script.Script) []string {
+		bc := bitcom.Decode(s)
+		require.Len(t, bc.Protocols, 1)
+		chunks, err := script.NewFromBytes(bc.Protocols[0].Script).Chunks()
+		require.NoError(t, err)
+		var data []string
+		for _, chunk := range chunks {
+			data = append(data, string(chunk.Data))
+		}
+		return data
+	}
+
+	s, err := ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: bitcom.MapCmdAdd, Key: "tags", Adds: []string{"art", "music"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ADD", "tags", "art", "music"}, pushes(s))
+
+	s, err = ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: bitcom.MapCmdDel, Data: map[string]string{"tags": "art", "collection": "x"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"DEL", "collection", "x", "tags", "art"}, pushes(s))
+
+	_, err = ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: bitcom.MapCmdSet, Data: map[string]string{"type": "ord"}})
+	require.ErrorIs(t, err, ErrMissingMapApp)
+	_, err = ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: bitcom.MapCmdSet, Data: map[string]string{"app": "test"}})
+	require.ErrorIs(t, err, ErrMissingMapType)
+	_, err = ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: bitcom.MapCmdAdd, Adds: []string{"art"}})
+	require.ErrorIs(t, err, bitcom.ErrMissingMapKey)
+	_, err = ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: bitcom.MapCmdDel})
+	require.ErrorIs(t, err, bitcom.ErrEmptyMap)
+	_, err = ordP2PKH.LockWithMapMetadata(&bitcom.Map{Cmd: "PUT", Data: map[string]string{"a": "b"}})
+	require.ErrorIs(t, err, bitcom.ErrUnsupportedMapCmd)
+}
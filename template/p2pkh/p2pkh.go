@@ -7,6 +7,8 @@ import (
 	"github.com/bsv-blockchain/go-sdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
 )
 
 var (
@@ -14,7 +16,9 @@ var (
 	ErrNoPrivateKey     = errors.New("private key not supplied")
 )
 
-func Decode(s *script.Script, mainnet bool) *script.Address {
+// Decode returns the address locked by a P2PKH script, or nil. The address is
+// encoded for the given network, defaulting to mainnet.
+func Decode(s *script.Script, network ...lib.Network) *script.Address {
 	if len(*s) != 25 {
 		return nil
 	}
@@ -23,7 +27,7 @@ func Decode(s *script.Script, mainnet bool) *script.Address {
 	} else if chunks[0].Op != script.OpDUP || chunks[1].Op != script.OpHASH160 || len(chunks[2].Data) != 20 || chunks[3].Op != script.OpEQUALVERIFY || chunks[4].Op != script.OpCHECKSIG {
 		return nil
 	} else {
-		address, _ := script.NewAddressFromPublicKeyHash(chunks[2].Data, mainnet)
+		address, _ := script.NewAddressFromPublicKeyHash(chunks[2].Data, lib.IsMainnet(network...))
 		return address
 	}
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/bsv-blockchain/go-sdk/transaction"
 	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bsv-blockchain/go-script-templates/lib"
 )
 
 func TestLocalUnlocker_UnlockAllInputs(t *testing.T) {
@@ -133,13 +135,13 @@ func TestDecode_ValidAndInvalid(t *testing.T) {
 	scriptHex := "76a914c0a3c167a28cabb9fbb495affa0761e6e74ac60d88ac"
 	s, err := script.NewFromHex(scriptHex)
 	require.NoError(t, err)
-	addr := Decode(s, true)
+	addr := Decode(s, lib.Mainnet)
 	require.NotNil(t, addr)
 	require.Len(t, addr.PublicKeyHash, 20)
 
 	// Invalid script (not 25 bytes)
 	invalidScript := script.Script([]byte{0x00, 0x01, 0x02})
-	addr = Decode(&invalidScript, true)
+	addr = Decode(&invalidScript, lib.Mainnet)
 	require.Nil(t, addr)
 }
 
@@ -157,7 +159,22 @@ func TestLock_RoundTrip(t *testing.T) {
 	require.NotNil(t, s)
 
 	// Decode the script back to address
-	decoded := Decode(s, true)
+	decoded := Decode(s, lib.Mainnet)
 	require.NotNil(t, decoded)
 	require.Equal(t, addr.AddressString, decoded.AddressString)
 }
+
+func TestDecode_Network(t *testing.T) {
+	s, err := script.NewFromHex("76a914c0a3c167a28cabb9fbb495affa0761e6e74ac60d88ac")
+	require.NoError(t, err)
+
+	mainnet := Decode(s)
+	require.NotNil(t, mainnet)
+	require.Equal(t, mainnet.AddressString, Decode(s, lib.Mainnet).AddressString)
+
+	testnet := Decode(s, lib.Testnet)
+	require.NotNil(t, testnet)
+	require.Equal(t, mainnet.PublicKeyHash, testnet.PublicKeyHash)
+	require.NotEqual(t, mainnet.AddressString, testnet.AddressString)
+	require.Contains(t, "mn", testnet.AddressString[:1])
+}